@@ -29,5 +29,3 @@ require (
 	k8s.io/utils v0.0.0-20241210054802-24370beab758 // indirect
 	sigs.k8s.io/yaml v1.4.0 // indirect
 )
-
-replace sigs.k8s.io/kubebuilder/v4 => github.com/mabulgu/kubebuilder/v4 v4.2.1-rust
@@ -0,0 +1,88 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rust
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// printColumnTypes lists the types of the additional printer columns of a CRD
+var printColumnTypes = []string{"integer", "number", "string", "boolean", "date"}
+
+// PrintColumn is an additional printer column of a CRD, shown by kubectl get
+type PrintColumn struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	JSONPath string `json:"jsonPath"`
+}
+
+// ParsePrintColumn parses a printer column of the form <name>:<type>:<jsonpath>, e.g.
+// Replicas:integer:.spec.replicas
+func ParsePrintColumn(column string) (PrintColumn, error) {
+	parts := strings.SplitN(column, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return PrintColumn{}, fmt.Errorf("invalid printer column %q, expected <name>:<type>:<jsonpath>", column)
+	}
+	if !slices.Contains(printColumnTypes, parts[1]) {
+		return PrintColumn{}, fmt.Errorf("invalid type %q of printer column %q, may be one of %v",
+			parts[1], parts[0], printColumnTypes)
+	}
+	// The API server only accepts simple JSON paths, which start with a dot
+	if !strings.HasPrefix(parts[2], ".") {
+		return PrintColumn{}, fmt.Errorf("invalid JSON path %q of printer column %q, expected a path starting with .",
+			parts[2], parts[0])
+	}
+	return PrintColumn{Name: parts[0], Type: parts[1], JSONPath: parts[2]}, nil
+}
+
+// JSON returns the column in the JSON form expected by the printcolumn attribute of kube
+func (c PrintColumn) JSON() string {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	// A struct of strings is always encodable
+	_ = encoder.Encode(c)
+	return strings.TrimSpace(buffer.String())
+}
+
+// Literal returns the JSON form of the column quoted as a Rust string literal
+func (c PrintColumn) Literal() string {
+	return StringLiteral(c.JSON())
+}
+
+// StringLiteral returns the value quoted as a Rust string literal
+func StringLiteral(value string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range value {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, "\\u{%x}", r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rust
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PrintColumn", func() {
+	DescribeTable("Literal should quote the column as a Rust string literal",
+		func(column, literal string) {
+			printColumn, err := ParsePrintColumn(column)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(printColumn.Literal()).To(Equal(literal))
+		},
+		Entry("a plain column", "Replicas:integer:.spec.replicas",
+			`"{\"name\":\"Replicas\",\"type\":\"integer\",\"jsonPath\":\".spec.replicas\"}"`),
+		Entry("a column with quotes", `Ready:string:.status.conditions[?(@.type=="Ready")].status`,
+			`"{\"name\":\"Ready\",\"type\":\"string\",`+
+				`\"jsonPath\":\".status.conditions[?(@.type==\\\"Ready\\\")].status\"}"`),
+		Entry("a column closing a raw string", `Size "#:string:.spec.size`,
+			`"{\"name\":\"Size \\\"#\",\"type\":\"string\",\"jsonPath\":\".spec.size\"}"`),
+		Entry("a column with a backslash", `Path\:string:.spec.path`,
+			`"{\"name\":\"Path\\\\\",\"type\":\"string\",\"jsonPath\":\".spec.path\"}"`),
+	)
+})
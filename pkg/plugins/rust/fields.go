@@ -0,0 +1,187 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rust
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// fieldNamePattern matches the names of the fields of a spec or a status, in camelCase or snake_case
+var fieldNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// fieldTypes maps the types of the fields to their Rust types and the values of the sample
+var fieldTypes = map[string]struct{ rustType, sample string }{
+	"string":  {"String", "example"},
+	"integer": {"i32", "1"},
+	"int32":   {"i32", "1"},
+	"int64":   {"i64", "1"},
+	"number":  {"f64", "1.5"},
+	"boolean": {"bool", "true"},
+}
+
+// rustKeywords lists the keywords which are written as raw identifiers when used as field names
+var rustKeywords = []string{
+	"as", "async", "await", "break", "const", "continue", "crate", "dyn", "else", "enum", "extern", "false",
+	"fn", "for", "if", "impl", "in", "let", "loop", "match", "mod", "move", "mut", "pub", "ref", "return",
+	"static", "struct", "trait", "true", "type", "unsafe", "use", "where", "while",
+}
+
+// Field is a field of the spec or the status of a CRD
+type Field struct {
+	// Name is the name of the field, in camelCase or snake_case
+	Name string
+
+	// Type is the type of the field, a scalar such as string or integer, a list []<type> or a map
+	// map[string]<type>
+	Type string
+
+	// Required indicates whether the field must be set
+	Required bool
+}
+
+// ParseField parses a field of the form <name>:<type>[:required], e.g. replicas:integer:required
+func ParseField(field string) (Field, error) {
+	parts := strings.Split(field, ":")
+	if len(parts) < 2 || len(parts) > 3 || (len(parts) == 3 && parts[2] != "required") {
+		return Field{}, fmt.Errorf("invalid field %q, expected <name>:<type>[:required]", field)
+	}
+	if !fieldNamePattern.MatchString(parts[0]) {
+		return Field{}, fmt.Errorf("invalid name of field %q, expected letters, digits and underscores", field)
+	}
+	if _, err := rustType(parts[1]); err != nil {
+		return Field{}, fmt.Errorf("invalid field %q: %w", field, err)
+	}
+	return Field{Name: parts[0], Type: parts[1], Required: len(parts) == 3}, nil
+}
+
+// RustName returns the snake_case name of the field in Rust, which serde renames to JSONName
+func (f Field) RustName() string {
+	name := strings.Join(f.words(), "_")
+	if slices.Contains(rustKeywords, name) {
+		return "r#" + name
+	}
+	return name
+}
+
+// JSONName returns the camelCase name of the field in the manifests
+func (f Field) JSONName() string {
+	words := f.words()
+	for i := 1; i < len(words); i++ {
+		words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+	}
+	return strings.Join(words, "")
+}
+
+// Description returns the description of the field, shown in the OpenAPI schema of the CRD
+func (f Field) Description(kind string) string {
+	description := strings.Join(f.words(), " ")
+	return fmt.Sprintf("%s%s of the %s.", strings.ToUpper(description[:1]), description[1:], kind)
+}
+
+// RustType returns the Rust type of the field, wrapped in an Option unless it is required
+func (f Field) RustType() string {
+	// The type was checked by ParseField
+	rustType, _ := rustType(f.Type)
+	if f.Required {
+		return rustType
+	}
+	return fmt.Sprintf("Option<%s>", rustType)
+}
+
+// IsMap indicates whether the field is a map or contains one
+func (f Field) IsMap() bool {
+	return strings.Contains(f.Type, "map[")
+}
+
+// SchemaHint returns the arguments of the schemars attribute validating the field, if any
+func (f Field) SchemaHint() string {
+	if !f.Required {
+		return ""
+	}
+	switch {
+	case f.Type == "string", strings.HasPrefix(f.Type, "[]"):
+		return "length(min = 1)"
+	default:
+		return ""
+	}
+}
+
+// Sample returns a value of the field in the YAML flow style, used by the sample of the CRD
+func (f Field) Sample() string {
+	return sample(f.Type)
+}
+
+// words splits the name of the field into lowercase words, at underscores and case changes
+func (f Field) words() []string {
+	var words []string
+	var word []rune
+	runes := []rune(f.Name)
+	for i, r := range runes {
+		startsWord := unicode.IsUpper(r) && i > 0 &&
+			(unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1])))
+		if (r == '_' || startsWord) && len(word) != 0 {
+			words = append(words, string(word))
+			word = nil
+		}
+		if r != '_' {
+			word = append(word, unicode.ToLower(r))
+		}
+	}
+	if len(word) != 0 {
+		words = append(words, string(word))
+	}
+	return words
+}
+
+// rustType returns the Rust type of a field type
+func rustType(fieldType string) (string, error) {
+	switch {
+	case strings.HasPrefix(fieldType, "[]"):
+		itemType, err := rustType(strings.TrimPrefix(fieldType, "[]"))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Vec<%s>", itemType), nil
+	case strings.HasPrefix(fieldType, "map[string]"):
+		valueType, err := rustType(strings.TrimPrefix(fieldType, "map[string]"))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("BTreeMap<String, %s>", valueType), nil
+	}
+	if t, ok := fieldTypes[fieldType]; ok {
+		return t.rustType, nil
+	}
+	return "", fmt.Errorf("unknown type %q, may be one of string, integer, int32, int64, number, boolean, "+
+		"[]<type> or map[string]<type>", fieldType)
+}
+
+// sample returns a value of a field type in the YAML flow style
+func sample(fieldType string) string {
+	switch {
+	case strings.HasPrefix(fieldType, "[]"):
+		return fmt.Sprintf("[%s]", sample(strings.TrimPrefix(fieldType, "[]")))
+	case strings.HasPrefix(fieldType, "map[string]"):
+		return fmt.Sprintf("{key: %s}", sample(strings.TrimPrefix(fieldType, "map[string]")))
+	}
+	return fieldTypes[fieldType].sample
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rust
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+// builtinGroup is a group of the built-in Kubernetes kinds provided by k8s-openapi
+type builtinGroup struct {
+	// domain is the domain of the group, empty for the groups without any
+	domain string
+	// module is the path of the k8s_openapi module holding the versions of the group
+	module string
+}
+
+// builtinGroups maps the groups of the built-in Kubernetes kinds to their domains and to their
+// modules in k8s-openapi. Only the groups of which k8s-openapi provides the types are listed.
+var builtinGroups = map[string]builtinGroup{
+	"admissionregistration": {"k8s.io", "k8s_openapi::api::admissionregistration"},
+	"apiextensions":         {"k8s.io", "k8s_openapi::apiextensions_apiserver::pkg::apis::apiextensions"},
+	"apiregistration":       {"k8s.io", "k8s_openapi::kube_aggregator::pkg::apis::apiregistration"},
+	"apps":                  {"", "k8s_openapi::api::apps"},
+	"authentication":        {"k8s.io", "k8s_openapi::api::authentication"},
+	"authorization":         {"k8s.io", "k8s_openapi::api::authorization"},
+	"autoscaling":           {"", "k8s_openapi::api::autoscaling"},
+	"batch":                 {"", "k8s_openapi::api::batch"},
+	"certificates":          {"k8s.io", "k8s_openapi::api::certificates"},
+	"coordination":          {"k8s.io", "k8s_openapi::api::coordination"},
+	"core":                  {"", "k8s_openapi::api::core"},
+	"discovery":             {"k8s.io", "k8s_openapi::api::discovery"},
+	"events":                {"k8s.io", "k8s_openapi::api::events"},
+	"flowcontrol.apiserver": {"k8s.io", "k8s_openapi::api::flowcontrol"},
+	"internal.apiserver":    {"k8s.io", "k8s_openapi::api::apiserverinternal"},
+	"networking":            {"k8s.io", "k8s_openapi::api::networking"},
+	"node":                  {"k8s.io", "k8s_openapi::api::node"},
+	"policy":                {"", "k8s_openapi::api::policy"},
+	"rbac.authorization":    {"k8s.io", "k8s_openapi::api::rbac"},
+	"resource":              {"k8s.io", "k8s_openapi::api::resource"},
+	"scheduling":            {"k8s.io", "k8s_openapi::api::scheduling"},
+	"storage":               {"k8s.io", "k8s_openapi::api::storage"},
+	"storagemigration":      {"k8s.io", "k8s_openapi::api::storagemigration"},
+}
+
+// builtinPlurals lists the resource names of the built-in kinds which are not the regular plural
+// of their kind
+var builtinPlurals = map[string]string{
+	"ComponentStatus":           "componentstatuses",
+	"CSIStorageCapacity":        "csistoragecapacities",
+	"DeviceClass":               "deviceclasses",
+	"Endpoints":                 "endpoints",
+	"Ingress":                   "ingresses",
+	"IngressClass":              "ingressclasses",
+	"MutatingAdmissionPolicy":   "mutatingadmissionpolicies",
+	"NetworkPolicy":             "networkpolicies",
+	"PriorityClass":             "priorityclasses",
+	"RuntimeClass":              "runtimeclasses",
+	"StorageClass":              "storageclasses",
+	"ValidatingAdmissionPolicy": "validatingadmissionpolicies",
+	"VolumeAttributesClass":     "volumeattributesclasses",
+}
+
+// clusterScopedKinds lists the built-in kinds which are not namespaced
+var clusterScopedKinds = map[string]bool{
+	"APIService":                     true,
+	"CertificateSigningRequest":      true,
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"CSIDriver":                      true,
+	"CSINode":                        true,
+	"IngressClass":                   true,
+	"MutatingWebhookConfiguration":   true,
+	"Namespace":                      true,
+	"Node":                           true,
+	"PersistentVolume":               true,
+	"PriorityClass":                  true,
+	"RuntimeClass":                   true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+	"VolumeAttachment":               true,
+}
+
+// KindReference refers to a kind by its qualified group, version and kind
+type KindReference struct {
+	// QualifiedGroup is the group of the kind including its domain, empty for the core group
+	QualifiedGroup string
+	Version        string
+	Kind           string
+}
+
+// ParseKindReference parses a reference to a kind of the form <group>/<version>/<Kind>, or
+// <version>/<Kind> for the kinds of the core group, e.g. apps/v1/Deployment or v1/ConfigMap.
+func ParseKindReference(ref string) (KindReference, error) {
+	parts := strings.Split(ref, "/")
+	for _, part := range parts {
+		if part == "" {
+			return KindReference{}, fmt.Errorf("invalid kind %q, expected <group>/<version>/<Kind>", ref)
+		}
+	}
+
+	switch len(parts) {
+	case 2:
+		return KindReference{Version: parts[0], Kind: parts[1]}, nil
+	case 3:
+		return KindReference{QualifiedGroup: parts[0], Version: parts[1], Kind: parts[2]}, nil
+	default:
+		return KindReference{}, fmt.Errorf("invalid kind %q, expected <group>/<version>/<Kind>", ref)
+	}
+}
+
+// String returns the reference in the form accepted by ParseKindReference
+func (r KindReference) String() string {
+	if r.QualifiedGroup == "" {
+		return fmt.Sprintf("%s/%s", r.Version, r.Kind)
+	}
+	return fmt.Sprintf("%s/%s/%s", r.QualifiedGroup, r.Version, r.Kind)
+}
+
+// BuiltinModule returns the k8s_openapi module of the version of a built-in kind, e.g.
+// k8s_openapi::api::core::v1 for v1/ConfigMap, and whether the kind is built-in.
+func (r KindReference) BuiltinModule() (string, bool) {
+	if r.QualifiedGroup == "" {
+		return fmt.Sprintf("%s::%s", builtinGroups["core"].module, r.Version), true
+	}
+	for name, group := range builtinGroups {
+		if r.QualifiedGroup == name || group.domain != "" && r.QualifiedGroup == name+"."+group.domain {
+			return fmt.Sprintf("%s::%s", group.module, r.Version), true
+		}
+	}
+	return "", false
+}
+
+// Plural returns the resource name of the referred built-in kind
+func (r KindReference) Plural() string {
+	if plural, found := builtinPlurals[r.Kind]; found {
+		return plural
+	}
+	return resource.RegularPlural(r.Kind)
+}
+
+// IsClusterScoped returns whether the referred built-in kind is not namespaced
+func (r KindReference) IsClusterScoped() bool {
+	return clusterScopedKinds[r.Kind]
+}
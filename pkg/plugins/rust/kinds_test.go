@@ -0,0 +1,92 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rust
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("KindReference", func() {
+	DescribeTable("BuiltinModule should return the k8s_openapi module of the group",
+		func(ref, module string) {
+			kind, err := ParseKindReference(ref)
+			Expect(err).NotTo(HaveOccurred())
+
+			path, builtin := kind.BuiltinModule()
+			Expect(builtin).To(BeTrue())
+			Expect(path).To(Equal(module))
+		},
+		Entry("core", "v1/ConfigMap", "k8s_openapi::api::core::v1"),
+		Entry("admissionregistration", "admissionregistration.k8s.io/v1/ValidatingWebhookConfiguration",
+			"k8s_openapi::api::admissionregistration::v1"),
+		Entry("apiextensions", "apiextensions.k8s.io/v1/CustomResourceDefinition",
+			"k8s_openapi::apiextensions_apiserver::pkg::apis::apiextensions::v1"),
+		Entry("apiregistration", "apiregistration.k8s.io/v1/APIService",
+			"k8s_openapi::kube_aggregator::pkg::apis::apiregistration::v1"),
+		Entry("apps", "apps/v1/Deployment", "k8s_openapi::api::apps::v1"),
+		Entry("authentication", "authentication.k8s.io/v1/TokenReview", "k8s_openapi::api::authentication::v1"),
+		Entry("authorization", "authorization.k8s.io/v1/SubjectAccessReview", "k8s_openapi::api::authorization::v1"),
+		Entry("autoscaling", "autoscaling/v2/HorizontalPodAutoscaler", "k8s_openapi::api::autoscaling::v2"),
+		Entry("batch", "batch/v1/Job", "k8s_openapi::api::batch::v1"),
+		Entry("certificates", "certificates.k8s.io/v1/CertificateSigningRequest",
+			"k8s_openapi::api::certificates::v1"),
+		Entry("coordination", "coordination.k8s.io/v1/Lease", "k8s_openapi::api::coordination::v1"),
+		Entry("discovery", "discovery.k8s.io/v1/EndpointSlice", "k8s_openapi::api::discovery::v1"),
+		Entry("events", "events.k8s.io/v1/Event", "k8s_openapi::api::events::v1"),
+		Entry("flowcontrol", "flowcontrol.apiserver.k8s.io/v1/FlowSchema", "k8s_openapi::api::flowcontrol::v1"),
+		Entry("apiserverinternal", "internal.apiserver.k8s.io/v1alpha1/StorageVersion",
+			"k8s_openapi::api::apiserverinternal::v1alpha1"),
+		Entry("networking", "networking.k8s.io/v1/Ingress", "k8s_openapi::api::networking::v1"),
+		Entry("node", "node.k8s.io/v1/RuntimeClass", "k8s_openapi::api::node::v1"),
+		Entry("policy", "policy/v1/PodDisruptionBudget", "k8s_openapi::api::policy::v1"),
+		Entry("rbac", "rbac.authorization.k8s.io/v1/Role", "k8s_openapi::api::rbac::v1"),
+		Entry("resource", "resource.k8s.io/v1beta1/ResourceClaim", "k8s_openapi::api::resource::v1beta1"),
+		Entry("scheduling", "scheduling.k8s.io/v1/PriorityClass", "k8s_openapi::api::scheduling::v1"),
+		Entry("storage", "storage.k8s.io/v1/StorageClass", "k8s_openapi::api::storage::v1"),
+		Entry("storagemigration", "storagemigration.k8s.io/v1alpha1/StorageVersionMigration",
+			"k8s_openapi::api::storagemigration::v1alpha1"),
+	)
+
+	DescribeTable("BuiltinModule should reject the groups k8s-openapi does not provide",
+		func(ref string) {
+			kind, err := ParseKindReference(ref)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, builtin := kind.BuiltinModule()
+			Expect(builtin).To(BeFalse())
+		},
+		Entry("auditregistration", "auditregistration.k8s.io/v1alpha1/AuditSink"),
+		Entry("extensions", "extensions/v1beta1/Ingress"),
+		Entry("imagepolicy", "imagepolicy.k8s.io/v1alpha1/ImageReview"),
+		Entry("metrics", "metrics.k8s.io/v1beta1/PodMetrics"),
+		Entry("setting", "settings.k8s.io/v1alpha1/PodPreset"),
+		Entry("external", "cache.example.com/v1/Memcached"),
+	)
+
+	DescribeTable("Plural should return the resource name of the kind",
+		func(kind, plural string) {
+			Expect(KindReference{Version: "v1", Kind: kind}.Plural()).To(Equal(plural))
+		},
+		Entry("a regular kind", "Deployment", "deployments"),
+		Entry("an uncountable kind", "Endpoints", "endpoints"),
+		Entry("a kind ending in s", "Ingress", "ingresses"),
+		Entry("a kind ending in y", "NetworkPolicy", "networkpolicies"),
+		Entry("a kind ending in a status", "ComponentStatus", "componentstatuses"),
+	)
+})
@@ -4,37 +4,24 @@ import (
 	"fmt"
 	"path/filepath"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
-	"strings"
 )
 
-const kbPrefix = "+kubebuilder:scaffold:"
-
-var commentsByExt = map[string]string{
-	".rs": "//",
+// kubebuilderExts maps the extensions of the scaffolded files to extensions which kubebuilder supports
+// markers for and which share their comment syntax
+var kubebuilderExts = map[string]string{
+	".rs":   ".go",
+	".toml": ".yaml",
+	".yaml": ".yaml",
+	".mk":   ".yaml",
 }
 
 func NewMarkerFor(path string, value string) machinery.Marker {
 	ext := filepath.Ext(path)
-	if comment, ok := commentsByExt[ext]; ok {
-		return machinery.Marker{
-			Prefix:  markerPrefix(kbPrefix),
-			Comment: comment,
-			Value:   value,
-		}
-
+	if filepath.Base(path) == "Makefile" {
+		ext = ".mk"
 	}
-	panic(fmt.Errorf("unsupported file extension: %s", ext))
-}
-
-func markerPrefix(prefix string) string {
-	trimmed := strings.TrimSpace(prefix)
-	var builder strings.Builder
-	if !strings.HasPrefix(trimmed, "+") {
-		builder.WriteString("+")
-	}
-	builder.WriteString(trimmed)
-	if !strings.HasSuffix(trimmed, ":") {
-		builder.WriteString(":")
+	if kbExt, ok := kubebuilderExts[ext]; ok {
+		return machinery.NewMarkerFor("marker"+kbExt, value)
 	}
-	return builder.String()
+	panic(fmt.Errorf("unsupported file extension: %s", ext))
 }
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rust
+
+import (
+	"fmt"
+	"path/filepath"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"strings"
+)
+
+// GroupModule returns the name of the Rust module of an API group, whose dashes and dots are not allowed
+// in identifiers, the core group being named core
+func GroupModule(group string) string {
+	if group == "" {
+		return "core"
+	}
+	return strings.NewReplacer("-", "_", ".", "_").Replace(group)
+}
+
+// GroupTypePrefix returns the prefix of the names of the Rust types of an API group, told apart from the
+// types of the other groups, e.g. CertManager for cert-manager
+func GroupTypePrefix(group string) string {
+	var prefix strings.Builder
+	for _, word := range strings.Split(GroupModule(group), "_") {
+		if word != "" {
+			prefix.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return prefix.String()
+}
+
+// APIDir returns the directory of the types of an API version, nested in the one of its group in the
+// multigroup layout
+func APIDir(gvk resource.GVK, multiGroup bool) string {
+	if multiGroup {
+		return filepath.Join("src", "api", GroupModule(gvk.Group), gvk.Version)
+	}
+	return filepath.Join("src", "api", gvk.Version)
+}
+
+// APIModule returns the path of the Rust module defining the types of a kind of the project, nested
+// in the module of its version
+func APIModule(gvk resource.GVK, multiGroup bool) string {
+	if multiGroup {
+		return fmt.Sprintf("crate::api::%s::%s::%s_types", GroupModule(gvk.Group), gvk.Version,
+			strings.ToLower(gvk.Kind))
+	}
+	return fmt.Sprintf("crate::api::%s::%s_types", gvk.Version, strings.ToLower(gvk.Kind))
+}
+
+// ControllerDir returns the directory of the controllers, nested in the one of the group of the reconciled
+// kind in the multigroup layout
+func ControllerDir(gvk resource.GVK, multiGroup bool) string {
+	if multiGroup {
+		return filepath.Join("src", "controller", GroupModule(gvk.Group))
+	}
+	return filepath.Join("src", "controller")
+}
+
+// ControllerModule returns the path of the Rust module defining the reconciler of a kind
+func ControllerModule(gvk resource.GVK, multiGroup bool) string {
+	if multiGroup {
+		return fmt.Sprintf("crate::controller::%s::%s_controller", GroupModule(gvk.Group), strings.ToLower(gvk.Kind))
+	}
+	return fmt.Sprintf("crate::controller::%s_controller", strings.ToLower(gvk.Kind))
+}
+
+// WebhookDir returns the directory of the webhooks of an API version, nested in the one of its group in
+// the multigroup layout
+func WebhookDir(gvk resource.GVK, multiGroup bool) string {
+	if multiGroup {
+		return filepath.Join("src", "webhook", GroupModule(gvk.Group), gvk.Version)
+	}
+	return filepath.Join("src", "webhook", gvk.Version)
+}
+
+// WebhookModule returns the path of the Rust module serving the webhooks of a kind, nested in the
+// module of its version
+func WebhookModule(gvk resource.GVK, multiGroup bool) string {
+	if multiGroup {
+		return fmt.Sprintf("crate::webhook::%s::%s::%s_webhook", GroupModule(gvk.Group), gvk.Version,
+			strings.ToLower(gvk.Kind))
+	}
+	return fmt.Sprintf("crate::webhook::%s::%s_webhook", gvk.Version, strings.ToLower(gvk.Kind))
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package rust
 
 import (
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 )
 
@@ -25,10 +26,23 @@ type Options struct {
 	Namespaced   bool
 	DoAPI        bool
 	DoController bool
+	DoDefaulting bool
+	DoValidation bool
+	DoConversion bool
+
+	// Plural is the irregular plural form of the resource, if any
+	Plural string
+
+	// ExternalAPIPath is the path of the Rust module defining the type of a kind whose API is
+	// neither scaffolded by the project nor built-in, e.g. a module of another crate
+	ExternalAPIPath string
+
+	// ExternalAPIDomain is the domain of the group of such a kind
+	ExternalAPIDomain string
 }
 
 // UpdateResource updates the provided resource with the options
-func (opts Options) UpdateResource(res *resource.Resource) {
+func (opts Options) UpdateResource(res *resource.Resource, c config.Config) {
 	if opts.DoAPI {
 		res.Path = ""
 
@@ -39,7 +53,51 @@ func (opts Options) UpdateResource(res *resource.Resource) {
 
 	}
 
+	if opts.Plural != "" {
+		res.Plural = opts.Plural
+	}
+
 	if opts.DoController {
 		res.Controller = true
 	}
+
+	if opts.DoDefaulting || opts.DoValidation || opts.DoConversion {
+		if res.Webhooks == nil {
+			res.Webhooks = &resource.Webhooks{}
+		}
+
+		res.Webhooks.WebhookVersion = "v1"
+		if opts.DoDefaulting {
+			res.Webhooks.Defaulting = true
+		}
+		if opts.DoValidation {
+			res.Webhooks.Validation = true
+		}
+		if opts.DoConversion {
+			res.Webhooks.Conversion = true
+		}
+	}
+
+	// The domain and the path of the type need to be changed in case we are referring to an external
+	// or builtin core resource which is neither being scaffolded now nor was scaffolded before by the
+	// project
+	if !opts.DoAPI {
+		loadedRes, err := c.GetResource(res.GVK)
+		alreadyHasAPI := err == nil && loadedRes.HasAPI()
+		if !alreadyHasAPI {
+			if opts.ExternalAPIPath != "" {
+				res.Path = opts.ExternalAPIPath
+				if opts.ExternalAPIDomain != "" {
+					res.Domain = opts.ExternalAPIDomain
+				}
+			} else if group, found := builtinGroups[res.Group]; found {
+				res.Domain = group.domain
+				kind := KindReference{QualifiedGroup: res.QualifiedGroup(), Version: res.Version, Kind: res.Kind}
+				res.Path, _ = kind.BuiltinModule()
+				if opts.Plural == "" {
+					res.Plural = kind.Plural()
+				}
+			}
+		}
+	}
 }
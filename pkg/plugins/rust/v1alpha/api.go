@@ -23,13 +23,16 @@ import (
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"log"
 	"os"
+	"os/exec"
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+	"slices"
 )
 
 const (
@@ -37,6 +40,21 @@ const (
 	namespacedFlag = "namespaced"
 	resourceFlag   = "resource"
 	controllerFlag = "controller"
+	skipFmtFlag    = "skip-fmt"
+	finalizerFlag  = "finalizer"
+	ownsFlag       = "owns"
+	watchesFlag    = "watches"
+
+	externalAPIPathFlag   = "external-api-path"
+	externalAPIDomainFlag = "external-api-domain"
+
+	pluralFlag      = "plural"
+	shortNamesFlag  = "short-names"
+	categoriesFlag  = "categories"
+	printColumnFlag = "printcolumn"
+
+	specFieldFlag   = "spec-field"
+	statusFieldFlag = "status-field"
 
 	isForced              = false
 	isNamespaced          = true
@@ -60,6 +78,28 @@ type createAPISubcommand struct {
 
 	// force indicates that the resource should be created even if it already exists
 	force bool
+
+	// skipFmt indicates that cargo fmt should not be run after scaffolding
+	skipFmt bool
+
+	// finalizer indicates that the controller should clean up through a finalizer before deletion
+	finalizer bool
+
+	// owns and watches are the secondary kinds owned and watched by the controller
+	owns    []string
+	watches []string
+
+	// shortNames, categories and printColumns customize how kubectl shows the resource
+	shortNames   []string
+	categories   []string
+	printColumns []string
+
+	// specFields and statusFields are the fields of the spec and the status of the resource
+	specFields   []string
+	statusFields []string
+
+	// pluginConfig holds the settings tracked in the PROJECT file
+	pluginConfig pluginConfig
 }
 
 func (p *createAPISubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
@@ -68,15 +108,36 @@ func (p *createAPISubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdM
 If information about whether the resource and controller should be scaffolded
 was not explicitly provided, it will prompt the user if they should be.
 
-After the scaffold is written, the dependencies will be updated and
-make generate will be run.
+After the scaffold is written, cargo fmt will be run if cargo is found in
+PATH, unless --skip-fmt is set.
 `
 	subcmdMeta.Examples = fmt.Sprintf(`  # Create a frigates API with Group: ship, Version: v1 and Kind: Frigate
   %[1]s create api --group ship --version v1 --kind Frigate
 
+  # Create a frigates API whose controller cleans up through a finalizer before deletion
+  %[1]s create api --group ship --version v1 --kind Frigate --finalizer
+
+  # Create a frigates API whose controller owns Deployments and watches ConfigMaps
+  %[1]s create api --group ship --version v1 --kind Frigate --owns apps/v1/Deployment --watches v1/ConfigMap
+
+  # Create a frigates API shown by kubectl get fr with its number of replicas
+  %[1]s create api --group ship --version v1 --kind Frigate --short-names fr --categories all \
+    --printcolumn Replicas:integer:.spec.replicas
+
+  # Create a frigates API with a required image and an optional number of replicas in its spec
+  %[1]s create api --group ship --version v1 --kind Frigate --spec-field image:string:required \
+    --spec-field replicas:integer --status-field readyReplicas:integer
+
+  # Create a controller for the built-in Deployment kind
+  %[1]s create api --group apps --version v1 --kind Deployment --resource=false --controller=true
+
+  # Create a controller for a kind whose type is defined by another crate
+  %[1]s create api --group cert-manager --version v1 --kind Certificate --resource=false --controller=true \
+    --external-api-path cert_manager_api::v1 --external-api-domain io
+
   # Edit the API Scheme
 
-  vim src/api/frigate_types.rs
+  vim src/api/v1/frigate_types.rs
 
   # Edit the Controller
   vim src/controller/frigate_controller.rs
@@ -105,11 +166,43 @@ func (p *createAPISubcommand) BindFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&p.options.DoController, controllerFlag, isControllerCreation,
 		"if set, generate the controller without prompting the user")
 	p.controllerFlag = fs.Lookup(controllerFlag)
+	fs.BoolVar(&p.finalizer, finalizerFlag, false,
+		"if set, scaffold a controller that registers a finalizer and splits reconciliation into apply and cleanup")
+	fs.StringVar(&p.options.Plural, pluralFlag, "", "resource irregular plural form")
+	fs.StringSliceVar(&p.shortNames, shortNamesFlag, nil, "short names of the resource, e.g. for kubectl get")
+	fs.StringSliceVar(&p.categories, categoriesFlag, nil, "categories of the resource, e.g. all")
+	fs.StringArrayVar(&p.printColumns, printColumnFlag, nil,
+		"additional column shown by kubectl get, as <name>:<type>:<jsonpath>, e.g. Replicas:integer:.spec.replicas")
+	fs.StringArrayVar(&p.specFields, specFieldFlag, nil,
+		"field of the spec of the resource, as <name>:<type>[:required], e.g. replicas:integer:required, "+
+			"where type is string, integer, int32, int64, number, boolean, []<type> or map[string]<type>")
+	fs.StringArrayVar(&p.statusFields, statusFieldFlag, nil,
+		"field of the status of the resource, as <name>:<type>[:required], e.g. readyReplicas:integer")
+
+	fs.StringVar(&p.options.ExternalAPIPath, externalAPIPathFlag, "",
+		"path of the Rust module defining the type of a kind which is neither an API of the project "+
+			"nor a built-in Kubernetes kind, e.g. a module of another crate")
+	fs.StringVar(&p.options.ExternalAPIDomain, externalAPIDomainFlag, "",
+		"domain of the group of a kind whose type is defined by --external-api-path")
+	fs.StringSliceVar(&p.owns, ownsFlag, nil,
+		"kinds created by the controller, as <group>/<version>/<Kind> or <version>/<Kind> for the core group, "+
+			"whose changes trigger the reconciliation of their owner")
+	fs.StringSliceVar(&p.watches, watchesFlag, nil,
+		"kinds watched by the controller, as <group>/<version>/<Kind> or <version>/<Kind> for the core group, "+
+			"whose changes are mapped to the resources to reconcile")
+
+	fs.BoolVar(&p.skipFmt, skipFmtFlag, false,
+		"if set, do not run cargo fmt on the scaffolded code")
 }
 
 func (p *createAPISubcommand) InjectConfig(c config.Config) error {
 	p.config = c
 
+	if err := c.DecodePluginConfig(pluginKey, &p.pluginConfig); err != nil &&
+		!errors.As(err, &config.PluginKeyNotFoundError{}) {
+		return err
+	}
+
 	return nil
 }
 
@@ -126,12 +219,59 @@ func (p *createAPISubcommand) InjectResource(res *resource.Resource) error {
 		p.options.DoController = util.YesNo(reader)
 	}
 
-	p.options.UpdateResource(p.resource)
+	p.options.UpdateResource(p.resource, p.config)
 
 	if err := p.resource.Validate(); err != nil {
 		return err
 	}
 
+	if p.options.DoAPI && (p.options.ExternalAPIPath != "" || p.options.ExternalAPIDomain != "") {
+		return fmt.Errorf("--%s and --%s cannot be used when the resource is scaffolded",
+			externalAPIPathFlag, externalAPIDomainFlag)
+	}
+	if p.options.DoController && !p.options.DoAPI && !p.resource.HasAPI() && p.resource.Path == "" {
+		if r, err := p.config.GetResource(p.resource.GVK); err != nil || !r.HasAPI() {
+			return fmt.Errorf("%s is neither an API of the project nor a built-in Kubernetes kind, "+
+				"use --%s to refer to the module defining its type", p.resource.Kind, externalAPIPathFlag)
+		}
+	}
+	if len(p.shortNames)+len(p.categories)+len(p.printColumns) != 0 && !p.options.DoAPI {
+		return fmt.Errorf("--%s, --%s and --%s require the resource to be scaffolded",
+			shortNamesFlag, categoriesFlag, printColumnFlag)
+	}
+	for _, shortName := range p.shortNames {
+		if err := validation.IsDNS1035Label(shortName); err != nil {
+			return fmt.Errorf("short name (%s) is invalid: %v", shortName, err)
+		}
+	}
+	for _, category := range p.categories {
+		if err := validation.IsDNS1035Label(category); err != nil {
+			return fmt.Errorf("category (%s) is invalid: %v", category, err)
+		}
+	}
+	for _, column := range p.printColumns {
+		if _, err := rust.ParsePrintColumn(column); err != nil {
+			return err
+		}
+	}
+	if len(p.specFields)+len(p.statusFields) != 0 && !p.options.DoAPI {
+		return fmt.Errorf("--%s and --%s require the resource to be scaffolded", specFieldFlag, statusFieldFlag)
+	}
+	if _, err := parseFields(p.specFields); err != nil {
+		return err
+	}
+	if _, err := parseFields(p.statusFields); err != nil {
+		return err
+	}
+	if len(p.owns)+len(p.watches) != 0 && !p.options.DoController {
+		return fmt.Errorf("--%s and --%s require the controller to be scaffolded", ownsFlag, watchesFlag)
+	}
+	for _, ref := range append(append([]string{}, p.owns...), p.watches...) {
+		if _, err := rust.ParseKindReference(ref); err != nil {
+			return err
+		}
+	}
+
 	// In case we want to scaffold a resource API we need to do some checks
 	if p.options.DoAPI {
 		// Check that resource doesn't have the API scaffolded or flag force was set
@@ -144,23 +284,67 @@ func (p *createAPISubcommand) InjectResource(res *resource.Resource) error {
 }
 
 func (p *createAPISubcommand) PreScaffold(machinery.Filesystem) error {
-	// check if main.rs is present in the src/ directory
-	if _, err := os.Stat(DefaultMainPath); os.IsNotExist(err) {
-		return fmt.Errorf("%s file should present in the root directory", DefaultMainPath)
-	}
-
-	return nil
+	return checkMainPath()
 }
 
 func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
-	scaffolder := scaffolds.NewAPIScaffolder(p.config, *p.resource, p.force)
+	options := scaffolds.APIOptions{
+		Finalizer:  p.finalizer,
+		ShortNames: p.shortNames,
+		Categories: p.categories,
+		Helm:       p.pluginConfig.Helm,
+		Namespaced: p.options.Namespaced,
+	}
+	for _, column := range p.printColumns {
+		printColumn, err := rust.ParsePrintColumn(column)
+		if err != nil {
+			return err
+		}
+		options.PrintColumns = append(options.PrintColumns, printColumn)
+	}
+	var err error
+	if options.SpecFields, err = parseFields(p.specFields); err != nil {
+		return err
+	}
+	if options.StatusFields, err = parseFields(p.statusFields); err != nil {
+		return err
+	}
+
+	// The relationships of the controller are tracked in the PROJECT file, so that they are kept
+	// when the controller is scaffolded again
+	var tracked *resourceConfig
+	if p.resource.HasController() {
+		tracked = p.pluginConfig.resourceConfig(p.resource.GVK)
+		if tracked == nil && len(p.owns)+len(p.watches) != 0 {
+			tracked = p.pluginConfig.addResourceConfig(p.resource.GVK)
+		}
+	}
+	if tracked != nil {
+		tracked.Owns = appendMissing(tracked.Owns, p.owns)
+		tracked.Watches = appendMissing(tracked.Watches, p.watches)
+
+		if options.Owns, err = parseKindReferences(tracked.Owns); err != nil {
+			return err
+		}
+		if options.Watches, err = parseKindReferences(tracked.Watches); err != nil {
+			return err
+		}
+	}
+
+	scaffolder := scaffolds.NewAPIScaffolder(p.config, *p.resource, p.force, options)
 	scaffolder.InjectFS(fs)
-	return scaffolder.Scaffold()
+	if err := scaffolder.Scaffold(); err != nil {
+		return err
+	}
+
+	if tracked == nil {
+		return nil
+	}
+	return encodePluginConfig(p.config, p.pluginConfig)
 }
 
 func (p *createAPISubcommand) PostScaffold() error {
-	err := util.RunCmd("Format code", "cargo", "fmt")
-	if err != nil {
+	if err := formatCode(p.skipFmt); err != nil {
 		return err
 	}
 	if p.resource.HasAPI() {
@@ -169,3 +353,66 @@ func (p *createAPISubcommand) PostScaffold() error {
 	}
 	return nil
 }
+
+// formatCode runs cargo fmt on the project unless skipped or cargo is not installed.
+// The scaffolded code is already formatted, so this only tidies up user changes around it.
+func formatCode(skip bool) error {
+	if skip {
+		return nil
+	}
+	if _, err := exec.LookPath("cargo"); err != nil {
+		log.Println("cargo was not found in PATH, skipping code formatting")
+		return nil
+	}
+	return util.RunCmd("Format code", "cargo", "fmt")
+}
+
+// appendMissing appends the values which are not in the slice yet
+func appendMissing(slice []string, values []string) []string {
+	for _, value := range values {
+		if !slices.Contains(slice, value) {
+			slice = append(slice, value)
+		}
+	}
+	return slice
+}
+
+// parseKindReferences parses the given references to kinds
+func parseKindReferences(refs []string) ([]rust.KindReference, error) {
+	kinds := make([]rust.KindReference, 0, len(refs))
+	for _, ref := range refs {
+		kind, err := rust.ParseKindReference(ref)
+		if err != nil {
+			return nil, err
+		}
+		kinds = append(kinds, kind)
+	}
+	return kinds, nil
+}
+
+// parseFields parses the fields of a spec or a status, whose names must be unique
+func parseFields(values []string) ([]rust.Field, error) {
+	fields := make([]rust.Field, 0, len(values))
+	names := map[string]bool{}
+	for _, value := range values {
+		field, err := rust.ParseField(value)
+		if err != nil {
+			return nil, err
+		}
+		if names[field.RustName()] {
+			return nil, fmt.Errorf("duplicate field %q", field.Name)
+		}
+		names[field.RustName()] = true
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// checkMainPath returns an error if main.rs is not present in the src/ directory
+func checkMainPath() error {
+	if _, err := os.Stat(DefaultMainPath); os.IsNotExist(err) {
+		return fmt.Errorf("%s file should present in the root directory", DefaultMainPath)
+	}
+
+	return nil
+}
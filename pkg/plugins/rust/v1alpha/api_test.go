@@ -19,12 +19,14 @@ package rust
 import (
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
 	"github.com/spf13/pflag"
 	"os"
+	"path/filepath"
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
-	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
 )
 
 var _ = Describe("API test", func() {
@@ -51,13 +53,99 @@ var _ = Describe("API test", func() {
 				DoAPI:        true,
 				DoController: true,
 			}
+			testConfig, _ := config.New(config.Version{Number: 3})
 			updateTestResource := resource.Resource{}
-			testAPIOptions.UpdateResource(&updateTestResource)
+			testAPIOptions.UpdateResource(&updateTestResource, testConfig)
 			Expect(updateTestResource.API.Namespaced).To(Equal(testAPIOptions.Namespaced))
 			Expect(updateTestResource.API.CRDVersion).To(Equal("v1"))
 			Expect(updateTestResource.Controller).To(Equal(testAPIOptions.DoController))
 			Expect(updateTestResource.Path).To(Equal(""))
 		})
+
+		It("should drop the domain of a builtin core group when only a controller is scaffolded", func() {
+			testAPIOptions := &rust.Options{DoController: true}
+			testConfig, _ := config.New(config.Version{Number: 3})
+			updateTestResource := resource.Resource{
+				GVK: resource.GVK{Group: "core", Domain: "example.com", Version: "v1", Kind: "Pod"},
+			}
+			testAPIOptions.UpdateResource(&updateTestResource, testConfig)
+			Expect(updateTestResource.Domain).To(BeEmpty())
+			Expect(updateTestResource.QualifiedGroup()).To(Equal("core"))
+			Expect(updateTestResource.Path).To(Equal("k8s_openapi::api::core::v1"))
+		})
+
+		It("should refer to the type of a builtin kind of a group with a domain", func() {
+			testAPIOptions := &rust.Options{DoController: true}
+			testConfig, _ := config.New(config.Version{Number: 3})
+			updateTestResource := resource.Resource{
+				GVK: resource.GVK{Group: "networking", Domain: "example.com", Version: "v1", Kind: "Ingress"},
+			}
+			testAPIOptions.UpdateResource(&updateTestResource, testConfig)
+			Expect(updateTestResource.QualifiedGroup()).To(Equal("networking.k8s.io"))
+			Expect(updateTestResource.Path).To(Equal("k8s_openapi::api::networking::v1"))
+		})
+
+		It("should refer to the type of a builtin kind of a group outside of the api module", func() {
+			testAPIOptions := &rust.Options{DoController: true}
+			testConfig, _ := config.New(config.Version{Number: 3})
+			updateTestResource := resource.Resource{
+				GVK: resource.GVK{
+					Group: "apiextensions", Domain: "example.com", Version: "v1", Kind: "CustomResourceDefinition",
+				},
+			}
+			testAPIOptions.UpdateResource(&updateTestResource, testConfig)
+			Expect(updateTestResource.QualifiedGroup()).To(Equal("apiextensions.k8s.io"))
+			Expect(updateTestResource.Path).
+				To(Equal("k8s_openapi::apiextensions_apiserver::pkg::apis::apiextensions::v1"))
+			Expect(updateTestResource.Plural).To(Equal("customresourcedefinitions"))
+		})
+
+		It("should set the resource name of a builtin kind", func() {
+			testAPIOptions := &rust.Options{DoController: true}
+			testConfig, _ := config.New(config.Version{Number: 3})
+			updateTestResource := resource.Resource{
+				GVK:    resource.GVK{Group: "core", Domain: "example.com", Version: "v1", Kind: "Endpoints"},
+				Plural: "endpointses",
+			}
+			testAPIOptions.UpdateResource(&updateTestResource, testConfig)
+			Expect(updateTestResource.Plural).To(Equal("endpoints"))
+		})
+
+		It("should not refer to a group k8s-openapi does not provide", func() {
+			testAPIOptions := &rust.Options{DoController: true}
+			testConfig, _ := config.New(config.Version{Number: 3})
+			updateTestResource := resource.Resource{
+				GVK: resource.GVK{Group: "extensions", Domain: "example.com", Version: "v1beta1", Kind: "Ingress"},
+			}
+			testAPIOptions.UpdateResource(&updateTestResource, testConfig)
+			Expect(updateTestResource.QualifiedGroup()).To(Equal("extensions.example.com"))
+			Expect(updateTestResource.Path).To(BeEmpty())
+		})
+
+		It("should refer to the type of an external kind", func() {
+			testAPIOptions := &rust.Options{
+				DoController:      true,
+				ExternalAPIPath:   "cert_manager_api::v1",
+				ExternalAPIDomain: "io",
+			}
+			testConfig, _ := config.New(config.Version{Number: 3})
+			updateTestResource := resource.Resource{
+				GVK: resource.GVK{Group: "cert-manager", Domain: "example.com", Version: "v1", Kind: "Certificate"},
+			}
+			testAPIOptions.UpdateResource(&updateTestResource, testConfig)
+			Expect(updateTestResource.QualifiedGroup()).To(Equal("cert-manager.io"))
+			Expect(updateTestResource.Path).To(Equal("cert_manager_api::v1"))
+		})
+
+		It("should keep the domain of a builtin core group when its API is scaffolded", func() {
+			testAPIOptions := &rust.Options{DoAPI: true, DoController: true}
+			testConfig, _ := config.New(config.Version{Number: 3})
+			updateTestResource := resource.Resource{
+				GVK: resource.GVK{Group: "apps", Domain: "example.com", Version: "v1", Kind: "Custom"},
+			}
+			testAPIOptions.UpdateResource(&updateTestResource, testConfig)
+			Expect(updateTestResource.QualifiedGroup()).To(Equal("apps.example.com"))
+		})
 	})
 
 	Describe("BindFlags", func() {
@@ -81,18 +169,10 @@ var _ = Describe("API test", func() {
 	})
 
 	Describe("PostScaffold", func() {
-		It("should return nil", func() {
-			// Create a temporary directory for testing
-			tmpDir, _ := os.MkdirTemp("", "test-dir-")
-			defer os.RemoveAll(tmpDir) // Clean up after the test
-
-			// Change the working directory to the temporary one
-			_ = os.Chdir(tmpDir)
-
-			err := util.RunCmd("Format code", "cargo", "init")
-			Expect(err).To(BeNil())
+		var testResource resource.Resource
 
-			testResource := resource.Resource{
+		BeforeEach(func() {
+			testResource = resource.Resource{
 				GVK: resource.GVK{
 					Group:   "test-group",
 					Version: "v1",
@@ -103,10 +183,20 @@ var _ = Describe("API test", func() {
 
 			testConfig, _ := config.New(config.Version{Number: 3})
 			testAPISubcommand.InjectConfig(testConfig)
-			err = testAPISubcommand.InjectResource(&testResource)
-			Expect(err).To(BeNil())
+			Expect(testAPISubcommand.InjectResource(&testResource)).To(Succeed())
+		})
 
-			Expect(testAPISubcommand.PostScaffold()).To(BeNil())
+		It("should not run cargo when formatting is skipped", func() {
+			testAPISubcommand.skipFmt = true
+			Expect(testAPISubcommand.PostScaffold()).To(Succeed())
+		})
+
+		It("should skip formatting when cargo is not installed", func() {
+			path := os.Getenv("PATH")
+			defer os.Setenv("PATH", path) //nolint:errcheck
+			Expect(os.Setenv("PATH", "")).To(Succeed())
+
+			Expect(testAPISubcommand.PostScaffold()).To(Succeed())
 		})
 	})
 
@@ -152,5 +242,243 @@ var _ = Describe("API test", func() {
 			Expect(testAPISubcommand.resource, testResource)
 			Expect(noErr).To(BeNil())
 		})
+
+		It("should fail on malformed secondary kinds", func() {
+			testResource := resource.Resource{
+				GVK:    resource.GVK{Group: "test-group", Version: "v1", Kind: "TestKind"},
+				Plural: "testkinds",
+			}
+
+			testConfig, _ := config.New(config.Version{Number: 3})
+			Expect(testAPISubcommand.InjectConfig(testConfig)).To(Succeed())
+			testAPISubcommand.owns = []string{"Deployment"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring("expected <group>/<version>/<Kind>")))
+
+			testAPISubcommand.owns = nil
+			testAPISubcommand.watches = []string{"apps//Deployment"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring("expected <group>/<version>/<Kind>")))
+		})
+
+		It("should fail on a controller for a kind without a known type", func() {
+			testResource := resource.Resource{
+				GVK:    resource.GVK{Group: "ship", Domain: "example.com", Version: "v1", Kind: "Frigate"},
+				Plural: "frigates",
+				API:    &resource.API{},
+			}
+
+			testConfig, _ := config.New(config.Version{Number: 3})
+			Expect(testAPISubcommand.InjectConfig(testConfig)).To(Succeed())
+			testAPISubcommand.options.DoAPI = false
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring("use --external-api-path")))
+
+			testAPISubcommand.options.ExternalAPIPath = "ship_api::v1"
+			Expect(testAPISubcommand.InjectResource(&testResource)).To(Succeed())
+		})
+
+		It("should fail on an external type when the resource is scaffolded", func() {
+			testResource := resource.Resource{
+				GVK:    resource.GVK{Group: "ship", Domain: "example.com", Version: "v1", Kind: "Frigate"},
+				Plural: "frigates",
+			}
+
+			testConfig, _ := config.New(config.Version{Number: 3})
+			Expect(testAPISubcommand.InjectConfig(testConfig)).To(Succeed())
+			testAPISubcommand.options.ExternalAPIPath = "ship_api::v1"
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring("cannot be used when the resource is scaffolded")))
+		})
+
+		It("should fail on malformed printer columns", func() {
+			testResource := resource.Resource{
+				GVK:    resource.GVK{Group: "test-group", Version: "v1", Kind: "TestKind"},
+				Plural: "testkinds",
+			}
+
+			testConfig, _ := config.New(config.Version{Number: 3})
+			Expect(testAPISubcommand.InjectConfig(testConfig)).To(Succeed())
+			testAPISubcommand.printColumns = []string{"Replicas:.spec.replicas"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring("expected <name>:<type>:<jsonpath>")))
+
+			testAPISubcommand.printColumns = []string{"Replicas:int:.spec.replicas"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring(`invalid type "int"`)))
+
+			testAPISubcommand.printColumns = []string{"Replicas:integer:.spec.replicas"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).To(Succeed())
+		})
+
+		DescribeTable("should fail on invalid kubectl settings",
+			func(setting func(*createAPISubcommand), message string) {
+				testResource := resource.Resource{
+					GVK:    resource.GVK{Group: "test-group", Version: "v1", Kind: "TestKind"},
+					Plural: "testkinds",
+				}
+
+				testConfig, _ := config.New(config.Version{Number: 3})
+				Expect(testAPISubcommand.InjectConfig(testConfig)).To(Succeed())
+				setting(&testAPISubcommand)
+				Expect(testAPISubcommand.InjectResource(&testResource)).To(MatchError(ContainSubstring(message)))
+			},
+			Entry("an uppercase short name", func(p *createAPISubcommand) {
+				p.shortNames = []string{"TK"}
+			}, "short name (TK) is invalid"),
+			Entry("a short name with a quote", func(p *createAPISubcommand) {
+				p.shortNames = []string{`tk"`}
+			}, `short name (tk") is invalid`),
+			Entry("a short name starting with a digit", func(p *createAPISubcommand) {
+				p.shortNames = []string{"1tk"}
+			}, "short name (1tk) is invalid"),
+			Entry("a category with a dot", func(p *createAPISubcommand) {
+				p.categories = []string{"all.example"}
+			}, "category (all.example) is invalid"),
+			Entry("a category with a backslash", func(p *createAPISubcommand) {
+				p.categories = []string{`all\`}
+			}, `category (all\) is invalid`),
+			Entry("an uppercase plural", func(p *createAPISubcommand) {
+				p.options.Plural = "TestKinds"
+			}, "invalid Plural"),
+			Entry("a plural with a quote", func(p *createAPISubcommand) {
+				p.options.Plural = `testkinds"`
+			}, "invalid Plural"),
+			Entry("a printer column without a name", func(p *createAPISubcommand) {
+				p.printColumns = []string{":integer:.spec.replicas"}
+			}, "expected <name>:<type>:<jsonpath>"),
+			Entry("a printer column of an unknown type", func(p *createAPISubcommand) {
+				p.printColumns = []string{"Replicas:int:.spec.replicas"}
+			}, `invalid type "int"`),
+			Entry("a printer column with a relative JSON path", func(p *createAPISubcommand) {
+				p.printColumns = []string{"Replicas:integer:spec.replicas"}
+			}, `invalid JSON path "spec.replicas"`),
+		)
+
+		It("should fail on kubectl settings without a resource", func() {
+			testResource := resource.Resource{
+				GVK:    resource.GVK{Group: "apps", Version: "v1", Kind: "Deployment"},
+				Plural: "deployments",
+			}
+
+			testConfig, _ := config.New(config.Version{Number: 3})
+			Expect(testAPISubcommand.InjectConfig(testConfig)).To(Succeed())
+			testAPISubcommand.options.DoAPI = false
+			testAPISubcommand.shortNames = []string{"deploy"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring("require the resource to be scaffolded")))
+		})
+
+		It("should fail on malformed fields", func() {
+			testResource := resource.Resource{
+				GVK:    resource.GVK{Group: "test-group", Version: "v1", Kind: "TestKind"},
+				Plural: "testkinds",
+			}
+
+			testConfig, _ := config.New(config.Version{Number: 3})
+			Expect(testAPISubcommand.InjectConfig(testConfig)).To(Succeed())
+			testAPISubcommand.specFields = []string{"replicas"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring("expected <name>:<type>[:required]")))
+
+			testAPISubcommand.specFields = []string{"replicas:integer:optional"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring("expected <name>:<type>[:required]")))
+
+			testAPISubcommand.specFields = []string{"replica-count:integer"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring("invalid name")))
+
+			testAPISubcommand.specFields = []string{"replicas:[]uint"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring(`unknown type "uint"`)))
+
+			testAPISubcommand.specFields = []string{"readyReplicas:integer", "ready_replicas:int64"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring(`duplicate field "ready_replicas"`)))
+
+			testAPISubcommand.specFields = []string{"replicas:integer:required", "labels:map[string]string"}
+			testAPISubcommand.statusFields = []string{"readyReplicas:integer"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).To(Succeed())
+		})
+
+		It("should fail on fields without a resource", func() {
+			testResource := resource.Resource{
+				GVK:    resource.GVK{Group: "apps", Version: "v1", Kind: "Deployment"},
+				Plural: "deployments",
+			}
+
+			testConfig, _ := config.New(config.Version{Number: 3})
+			Expect(testAPISubcommand.InjectConfig(testConfig)).To(Succeed())
+			testAPISubcommand.options.DoAPI = false
+			testAPISubcommand.statusFields = []string{"readyReplicas:integer"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError(ContainSubstring("require the resource to be scaffolded")))
+		})
+
+		It("should set the irregular plural of the resource", func() {
+			testResource := resource.Resource{
+				GVK:    resource.GVK{Group: "test-group", Version: "v1", Kind: "Cactus"},
+				Plural: "cactuses",
+			}
+
+			testConfig, _ := config.New(config.Version{Number: 3})
+			Expect(testAPISubcommand.InjectConfig(testConfig)).To(Succeed())
+			testAPISubcommand.options.Plural = "cacti"
+			Expect(testAPISubcommand.InjectResource(&testResource)).To(Succeed())
+			Expect(testResource.Plural).To(Equal("cacti"))
+		})
+
+		It("should fail on secondary kinds without a controller", func() {
+			testResource := resource.Resource{
+				GVK:    resource.GVK{Group: "test-group", Version: "v1", Kind: "TestKind"},
+				Plural: "testkinds",
+			}
+
+			testConfig, _ := config.New(config.Version{Number: 3})
+			Expect(testAPISubcommand.InjectConfig(testConfig)).To(Succeed())
+			testAPISubcommand.options.DoController = false
+			testAPISubcommand.watches = []string{"v1/ConfigMap"}
+			Expect(testAPISubcommand.InjectResource(&testResource)).
+				To(MatchError("--owns and --watches require the controller to be scaffolded"))
+		})
+	})
+
+	Describe("Scaffold", func() {
+		It("should keep the secondary kinds of previous scaffolds of the controller", func() {
+			project := newTestProject("")
+			project.init(&initSubcommand{
+				commandName: "operator-sdk",
+				domain:      "example.com",
+				projectName: "test-operator",
+				license:     "none",
+			})
+
+			project.createAPI(&createAPISubcommand{
+				resourceFlag:   &pflag.Flag{Changed: true},
+				controllerFlag: &pflag.Flag{Changed: true},
+				options:        &rust.Options{Namespaced: true, DoAPI: true, DoController: true},
+				owns:           []string{"apps/v1/Deployment"},
+			}, "cache", "v1alpha1", "Memcached")
+			project.createAPI(&createAPISubcommand{
+				resourceFlag:   &pflag.Flag{Changed: true},
+				controllerFlag: &pflag.Flag{Changed: true},
+				options:        &rust.Options{Namespaced: true, DoAPI: true, DoController: true},
+				force:          true,
+				watches:        []string{"v1/ConfigMap"},
+			}, "cache", "v1alpha1", "Memcached")
+
+			tracked := pluginConfig{}
+			Expect(project.config().DecodePluginConfig(pluginKey, &tracked)).To(Succeed())
+			Expect(tracked.Resources).To(HaveLen(1))
+			Expect(tracked.Resources[0].Kind).To(Equal("Memcached"))
+			Expect(tracked.Resources[0].Owns).To(Equal([]string{"apps/v1/Deployment"}))
+			Expect(tracked.Resources[0].Watches).To(Equal([]string{"v1/ConfigMap"}))
+
+			controller, err := afero.ReadFile(project.fs.FS, filepath.Join("src", "controller", "memcached_controller.rs"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(controller)).To(ContainSubstring("scoped_api::<Deployment>"))
+			Expect(string(controller)).To(ContainSubstring("scoped_api::<ConfigMap>"))
+		})
 	})
 })
@@ -0,0 +1,35 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rust
+
+import (
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+// NewBundle returns the rust/v1alpha plugin composed with the given plugins under its own key, so that
+// the projects it initialized keep their layout. The manifests.sdk.operatorframework.io/v2 and
+// scorecard.sdk.operatorframework.io/v2 plugins are internal to operator-sdk, and are therefore given
+// by its CLI, which registers the bundle instead of the plugin, e.g.:
+//
+//	rustBundle, _ := rust.NewBundle(manifestsv2.Plugin{}, scorecardv2.Plugin{})
+func NewBundle(plugins ...plugin.Plugin) (plugin.Bundle, error) {
+	return plugin.NewBundleWithOptions(
+		plugin.WithName(pluginName),
+		plugin.WithVersion(pluginVersion),
+		plugin.WithPlugins(append([]plugin.Plugin{Plugin{}}, plugins...)...),
+	)
+}
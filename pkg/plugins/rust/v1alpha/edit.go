@@ -0,0 +1,193 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rust
+
+import (
+	"errors"
+	"fmt"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"strings"
+)
+
+const (
+	multigroupFlag = "multigroup"
+	licenseFlag    = "license"
+	ownerFlag      = "owner"
+	imageFlag      = "image"
+	helmFlag       = "helm"
+
+	defaultLicense = "apache2"
+)
+
+// supportedLicenses are the values accepted by the license flags of the init and edit subcommands
+var supportedLicenses = []string{"apache2", "copyright", "none"}
+
+// licenseUsage is the help of the license flags of the init and edit subcommands
+var licenseUsage = fmt.Sprintf("license to use to boilerplate, may be one of '%s'",
+	strings.Join(supportedLicenses, "', '"))
+
+var _ plugin.EditSubcommand = &editSubcommand{}
+
+type editSubcommand struct {
+	config config.Config
+
+	// pluginConfig holds the settings tracked in the PROJECT file
+	pluginConfig pluginConfig
+	// untracked is set when the PROJECT file does not track the settings, whose boilerplate settings
+	// are then read from the boilerplate file
+	untracked bool
+
+	// Flags
+	multigroup bool
+	license    string
+	owner      string
+	image      string
+
+	// Check which settings have to be changed
+	multigroupFlag *pflag.Flag
+	licenseFlag    *pflag.Flag
+	ownerFlag      *pflag.Flag
+	imageFlag      *pflag.Flag
+}
+
+func (p *editSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	subcmdMeta.Description = `This command will edit the project configuration.
+Features supported:
+  - Toggle between single or multi group projects, as long as the project has no APIs.
+  - Change the license and owner of the "hack/boilerplate.rs.txt" and "Cargo.toml" files.
+  - Change the image name used by the "Makefile".
+
+Only the settings passed as flags are changed, and user code is never modified.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Enable the multigroup layout
+  %[1]s edit --multigroup
+
+  # Disable the multigroup layout
+  %[1]s edit --multigroup=false
+
+  # Change the copyright owner
+  %[1]s edit --owner "Your name"
+
+  # Change the image name used by make targets
+  %[1]s edit --image quay.io/example/operator:v0.1.0
+`, cliMeta.CommandName)
+}
+
+func (p *editSubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&p.multigroup, multigroupFlag, false, "enable or disable multigroup layout")
+	p.multigroupFlag = fs.Lookup(multigroupFlag)
+
+	// boilerplate args
+	fs.StringVar(&p.license, licenseFlag, defaultLicense, licenseUsage)
+	p.licenseFlag = fs.Lookup(licenseFlag)
+	fs.StringVar(&p.owner, ownerFlag, "", "owner to add to the copyright")
+	p.ownerFlag = fs.Lookup(ownerFlag)
+
+	fs.StringVar(&p.image, imageFlag, "", "image name used by the Makefile targets")
+	p.imageFlag = fs.Lookup(imageFlag)
+}
+
+func (p *editSubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+
+	p.pluginConfig = pluginConfig{}
+	if err := c.DecodePluginConfig(pluginKey, &p.pluginConfig); errors.As(err, &config.PluginKeyNotFoundError{}) {
+		p.untracked = true
+	} else if err != nil {
+		return err
+	}
+
+	if p.licenseFlag.Changed {
+		if err := validateLicense(p.license); err != nil {
+			return err
+		}
+	}
+	// The modules of the existing APIs are not moved, so the layout can only change while there are none
+	if p.multigroupFlag.Changed && p.multigroup != c.IsMultiGroup() {
+		resources, err := c.GetResources()
+		if err != nil {
+			return err
+		}
+		if len(resources) != 0 {
+			return fmt.Errorf("--%s cannot be changed once the project has APIs", multigroupFlag)
+		}
+	}
+	if p.imageFlag.Changed && p.image == "" {
+		return fmt.Errorf("--%s cannot be empty", imageFlag)
+	}
+
+	return nil
+}
+
+func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
+	// Keep the license and owner of the boilerplate of projects which do not track them
+	if p.untracked {
+		license, owner, err := scaffolds.DetectBoilerplate(fs)
+		if err != nil {
+			return err
+		}
+		p.pluginConfig.License = license
+		p.pluginConfig.Owner = owner
+	}
+
+	options := scaffolds.EditOptions{}
+	if p.multigroupFlag.Changed {
+		options.Multigroup = &p.multigroup
+	}
+	if p.licenseFlag.Changed {
+		options.License = &p.license
+		p.pluginConfig.License = p.license
+	}
+	if p.ownerFlag.Changed {
+		options.Owner = &p.owner
+		p.pluginConfig.Owner = p.owner
+	}
+	if p.imageFlag.Changed {
+		options.Image = &p.image
+		p.pluginConfig.Image = p.image
+	}
+
+	scaffolder := scaffolds.NewEditScaffolder(p.config, options, p.pluginConfig.License, p.pluginConfig.Owner)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return err
+	}
+
+	return encodePluginConfig(p.config, p.pluginConfig)
+}
+
+// validateLicense returns an error if the license is not supported
+func validateLicense(license string) error {
+	for _, supported := range supportedLicenses {
+		if license == supported {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported license %q, may be one of %v", license, supportedLicenses)
+}
+
+// encodePluginConfig stores the plugin settings in the PROJECT file, if the config supports it
+func encodePluginConfig(c config.Config, cfg pluginConfig) error {
+	if err := c.EncodePluginConfig(pluginKey, cfg); err != nil && !errors.As(err, &config.UnsupportedFieldError{}) {
+		return err
+	}
+	return nil
+}
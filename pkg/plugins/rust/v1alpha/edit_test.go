@@ -0,0 +1,158 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rust
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("Edit test", func() {
+	var (
+		testEditSubcommand editSubcommand
+		testConfig         config.Config
+		flagTest           *pflag.FlagSet
+	)
+
+	BeforeEach(func() {
+		testEditSubcommand = editSubcommand{}
+		testConfig, _ = config.New(config.Version{Number: 3})
+		flagTest = pflag.NewFlagSet("testFlag", pflag.ContinueOnError)
+		testEditSubcommand.BindFlags(flagTest)
+	})
+
+	Describe("BindFlags", func() {
+		It("verify all fields were set correctly", func() {
+			Expect(testEditSubcommand.multigroup).To(BeFalse())
+			Expect(testEditSubcommand.license).To(Equal("apache2"))
+			Expect(testEditSubcommand.owner).To(Equal(""))
+			Expect(testEditSubcommand.image).To(Equal(""))
+		})
+	})
+
+	Describe("InjectConfig", func() {
+		It("should load the tracked settings", func() {
+			Expect(testConfig.EncodePluginConfig(pluginKey, pluginConfig{Owner: "Test Owner"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(testEditSubcommand.config).To(Equal(testConfig))
+			Expect(testEditSubcommand.pluginConfig.Owner).To(Equal("Test Owner"))
+		})
+
+		It("should not assume the settings of projects without tracked settings", func() {
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(testEditSubcommand.untracked).To(BeTrue())
+			Expect(testEditSubcommand.pluginConfig).To(Equal(pluginConfig{}))
+		})
+
+		It("should fail for an unsupported license", func() {
+			Expect(flagTest.Parse([]string{"--license", "mit"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(HaveOccurred())
+		})
+
+		It("should fail to change the layout of a project with APIs", func() {
+			Expect(testConfig.AddResource(resource.Resource{
+				GVK: resource.GVK{Group: "cache", Domain: "example.com", Version: "v1alpha1", Kind: "Memcached"},
+			})).To(Succeed())
+			Expect(flagTest.Parse([]string{"--multigroup"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).
+				To(MatchError("--multigroup cannot be changed once the project has APIs"))
+		})
+
+		It("should keep the layout of a project with APIs", func() {
+			Expect(testConfig.AddResource(resource.Resource{
+				GVK: resource.GVK{Group: "cache", Domain: "example.com", Version: "v1alpha1", Kind: "Memcached"},
+			})).To(Succeed())
+			Expect(flagTest.Parse([]string{"--multigroup=false"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+		})
+
+		It("should fail for an empty image", func() {
+			Expect(flagTest.Parse([]string{"--image", ""})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(HaveOccurred())
+		})
+	})
+
+	Describe("Scaffold", func() {
+		It("should only change the settings passed as flags", func() {
+			fs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+			Expect(afero.WriteFile(fs.FS, "Makefile", []byte("IMG ?= test:latest\n"), 0o644)).To(Succeed())
+			Expect(testConfig.EncodePluginConfig(pluginKey, pluginConfig{License: "none", Owner: "Test Owner"})).
+				To(Succeed())
+
+			Expect(flagTest.Parse([]string{"--multigroup", "--image", "example.com/test:v1"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(testEditSubcommand.Scaffold(fs)).To(Succeed())
+
+			Expect(testConfig.IsMultiGroup()).To(BeTrue())
+			tracked := pluginConfig{}
+			Expect(testConfig.DecodePluginConfig(pluginKey, &tracked)).To(Succeed())
+			Expect(tracked).To(Equal(pluginConfig{License: "none", Owner: "Test Owner", Image: "example.com/test:v1"}))
+
+			makefile, err := afero.ReadFile(fs.FS, "Makefile")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(makefile)).To(Equal("IMG ?= example.com/test:v1\n"))
+		})
+
+		It("should keep a project without tracked settings nor boilerplate unlicensed", func() {
+			fs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+			Expect(afero.WriteFile(fs.FS, "Cargo.toml", []byte("[package]\nname = \"test\"\n"), 0o644)).To(Succeed())
+
+			Expect(flagTest.Parse([]string{"--owner", "Test Owner"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(testEditSubcommand.Scaffold(fs)).To(Succeed())
+
+			exists, err := afero.Exists(fs.FS, filepath.Join("hack", "boilerplate.rs.txt"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+			cargoToml, err := afero.ReadFile(fs.FS, "Cargo.toml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(cargoToml)).To(Equal("[package]\nname = \"test\"\nauthors = [\"Test Owner\"]\n"))
+
+			tracked := pluginConfig{}
+			Expect(testConfig.DecodePluginConfig(pluginKey, &tracked)).To(Succeed())
+			Expect(tracked).To(Equal(pluginConfig{License: "none", Owner: "Test Owner"}))
+		})
+
+		It("should read the settings of a project without tracked settings from its boilerplate", func() {
+			fs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+			Expect(afero.WriteFile(fs.FS, "Cargo.toml", []byte("[package]\nname = \"test\"\n"), 0o644)).To(Succeed())
+			Expect(afero.WriteFile(fs.FS, filepath.Join("hack", "boilerplate.rs.txt"),
+				[]byte("/*\nCopyright 2024 Test Owner.\n\nLicensed under the Apache License, Version 2.0\n*/"), 0o644)).
+				To(Succeed())
+
+			Expect(flagTest.Parse([]string{"--license", "copyright"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(testEditSubcommand.Scaffold(fs)).To(Succeed())
+
+			boilerplate, err := afero.ReadFile(fs.FS, filepath.Join("hack", "boilerplate.rs.txt"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(boilerplate)).To(MatchRegexp(`Copyright \d+ Test Owner\.`))
+			Expect(string(boilerplate)).NotTo(ContainSubstring("Licensed under the Apache License"))
+
+			tracked := pluginConfig{}
+			Expect(testConfig.DecodePluginConfig(pluginKey, &tracked)).To(Succeed())
+			Expect(tracked).To(Equal(pluginConfig{License: "copyright", Owner: "Test Owner"}))
+		})
+	})
+})
@@ -0,0 +1,268 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rust
+
+import (
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/internal/golden"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+	"os"
+	"path/filepath"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config/store"
+	yamlstore "sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+const (
+	// memcachedOperatorDir is the sample project of the repository, which implements the reconciliation
+	// of the Memcached on top of memcachedScaffoldDir
+	memcachedOperatorDir = "../../../../testdata/memcached-operator"
+	// memcachedScaffoldDir is the scaffolded project of the sample
+	memcachedScaffoldDir = "testdata/memcached-operator"
+	// memcachedReconcilerPath is the file of the sample holding its reconciliation
+	memcachedReconcilerPath = "src/controller/memcached_controller.rs"
+	// multiAPIProjectDir is a project with several APIs of different scopes
+	multiAPIProjectDir = "testdata/multi-api"
+	// multiGroupProjectDir is a project whose APIs are organized by group
+	multiGroupProjectDir = "testdata/multigroup"
+)
+
+var _ = Describe("testdata/memcached-operator", func() {
+	It("should match a freshly scaffolded project with the reconciliation of the sample", func() {
+		project := newTestProject(memcachedOperatorDir)
+
+		// operator-sdk init --plugins rust/v1alpha --domain example.com
+		project.init(&initSubcommand{
+			commandName: "operator-sdk",
+			domain:      "example.com",
+			projectName: "memcached-operator",
+			license:     "apache2",
+		})
+
+		// operator-sdk create api --group cache --version v1alpha1 --kind Memcached --resource --controller
+		//   --owns apps/v1/Deployment --spec-field size:int32:required --spec-field containerPort:int32
+		project.createAPI(&createAPISubcommand{
+			resourceFlag:   &pflag.Flag{Changed: true},
+			controllerFlag: &pflag.Flag{Changed: true},
+			options: &rust.Options{
+				Namespaced:   true,
+				DoAPI:        true,
+				DoController: true,
+			},
+			owns:       []string{"apps/v1/Deployment"},
+			specFields: []string{"size:int32:required", "containerPort:int32"},
+		}, "cache", "v1alpha1", "Memcached")
+
+		project.expectGolden(memcachedScaffoldDir)
+
+		project.copyFiles(memcachedOperatorDir, memcachedReconcilerPath)
+		golden.ExpectTree(project.fs.FS, memcachedOperatorDir)
+	})
+
+	It("should scaffold a project with several APIs", func() {
+		project := newTestProject(multiAPIProjectDir)
+
+		// operator-sdk init --plugins rust/v1alpha --domain example.com --owner "The Operator Authors"
+		project.init(&initSubcommand{
+			commandName: "operator-sdk",
+			domain:      "example.com",
+			projectName: "multi-api",
+			license:     "apache2",
+			owner:       "The Operator Authors",
+		})
+
+		// operator-sdk create api --group cache --version v1alpha1 --kind Memcached --resource --controller
+		project.createAPI(&createAPISubcommand{
+			resourceFlag:   &pflag.Flag{Changed: true},
+			controllerFlag: &pflag.Flag{Changed: true},
+			options: &rust.Options{
+				Namespaced:   true,
+				DoAPI:        true,
+				DoController: true,
+			},
+		}, "cache", "v1alpha1", "Memcached")
+
+		// operator-sdk create api --group tenancy --version v1 --kind Tenant --namespaced=false
+		//   --owns v1/Namespace --watches cache.example.com/v1alpha1/Memcached
+		project.createAPI(&createAPISubcommand{
+			resourceFlag:   &pflag.Flag{Changed: true},
+			controllerFlag: &pflag.Flag{Changed: true},
+			options: &rust.Options{
+				Namespaced:   false,
+				DoAPI:        true,
+				DoController: true,
+			},
+			owns:    []string{"v1/Namespace"},
+			watches: []string{"cache.example.com/v1alpha1/Memcached"},
+		}, "tenancy", "v1", "Tenant")
+
+		// operator-sdk create api --group apps --version v1 --kind Deployment --resource=false --controller
+		project.createAPI(&createAPISubcommand{
+			resourceFlag:   &pflag.Flag{Changed: true},
+			controllerFlag: &pflag.Flag{Changed: true},
+			options: &rust.Options{
+				Namespaced:   true,
+				DoController: true,
+			},
+		}, "apps", "v1", "Deployment")
+
+		project.expectGolden(multiAPIProjectDir)
+	})
+
+	It("should scaffold a project with the multigroup layout", func() {
+		project := newTestProject(multiGroupProjectDir)
+
+		// operator-sdk init --plugins rust/v1alpha --domain example.com --multigroup
+		project.init(&initSubcommand{
+			commandName: "operator-sdk",
+			domain:      "example.com",
+			projectName: "multigroup",
+			license:     "apache2",
+			multigroup:  true,
+		})
+
+		// operator-sdk create api --group ship --version v1 --kind Frigate --resource --controller
+		// operator-sdk create api --group sea-creatures --version v1 --kind Frigate --resource --controller
+		for _, group := range []string{"ship", "sea-creatures"} {
+			project.createAPI(&createAPISubcommand{
+				resourceFlag:   &pflag.Flag{Changed: true},
+				controllerFlag: &pflag.Flag{Changed: true},
+				options: &rust.Options{
+					Namespaced:   true,
+					DoAPI:        true,
+					DoController: true,
+				},
+			}, group, "v1", "Frigate")
+		}
+
+		// operator-sdk create api --group ship --version v2 --kind Frigate --resource --controller=false
+		project.createAPI(&createAPISubcommand{
+			resourceFlag:   &pflag.Flag{Changed: true},
+			controllerFlag: &pflag.Flag{Changed: true},
+			options: &rust.Options{
+				Namespaced: true,
+				DoAPI:      true,
+			},
+		}, "ship", "v2", "Frigate")
+
+		// operator-sdk create webhook --group ship --version v1 --kind Frigate --defaulting
+		// operator-sdk create webhook --group sea-creatures --version v1 --kind Frigate --defaulting
+		for _, group := range []string{"ship", "sea-creatures"} {
+			project.createWebhook(&createWebhookSubcommand{
+				commandName: "operator-sdk",
+				options:     &rust.Options{DoDefaulting: true},
+			}, group, "v1", "Frigate")
+		}
+
+		project.expectGolden(multiGroupProjectDir)
+	})
+})
+
+// testProject runs the plugin subcommands against an in-memory filesystem the same way the CLI does
+type testProject struct {
+	fs    machinery.Filesystem
+	store store.Store
+}
+
+// newTestProject returns a project whose boilerplate is copied from the given golden directory, if
+// any, so that the scaffolded files do not depend on the current year
+func newTestProject(goldenDir string) *testProject {
+	fs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+
+	boilerplatePath := filepath.Join("hack", "boilerplate.rs.txt")
+	boilerplate, err := os.ReadFile(filepath.Join(goldenDir, boilerplatePath))
+	if err == nil {
+		Expect(afero.WriteFile(fs.FS, boilerplatePath, boilerplate, 0o644)).To(Succeed())
+	} else {
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	}
+
+	s := yamlstore.New(fs)
+	Expect(s.New(config.Version{Number: 3})).To(Succeed())
+	Expect(s.Config().SetPluginChain([]string{pluginKey})).To(Succeed())
+
+	return &testProject{fs: fs, store: s}
+}
+
+// config returns the project configuration that is saved as the PROJECT file
+func (p *testProject) config() config.Config {
+	return p.store.Config()
+}
+
+// init runs the init subcommand
+func (p *testProject) init(cmd *initSubcommand) {
+	Expect(cmd.InjectConfig(p.config())).To(Succeed())
+	Expect(cmd.Scaffold(p.fs)).To(Succeed())
+}
+
+// createAPI runs the create api subcommand for a resource built the same way the CLI builds it
+func (p *testProject) createAPI(cmd *createAPISubcommand, group, version, kind string) {
+	res := &resource.Resource{
+		GVK: resource.GVK{
+			Group:   group,
+			Domain:  p.config().GetDomain(),
+			Version: version,
+			Kind:    kind,
+		},
+		Plural:   resource.RegularPlural(kind),
+		API:      &resource.API{},
+		Webhooks: &resource.Webhooks{},
+	}
+	Expect(cmd.InjectConfig(p.config())).To(Succeed())
+	Expect(cmd.InjectResource(res)).To(Succeed())
+	Expect(cmd.Scaffold(p.fs)).To(Succeed())
+}
+
+// createWebhook runs the create webhook subcommand for a resource built the same way the CLI builds it
+func (p *testProject) createWebhook(cmd *createWebhookSubcommand, group, version, kind string) {
+	res := &resource.Resource{
+		GVK: resource.GVK{
+			Group:   group,
+			Domain:  p.config().GetDomain(),
+			Version: version,
+			Kind:    kind,
+		},
+		Plural:   resource.RegularPlural(kind),
+		API:      &resource.API{},
+		Webhooks: &resource.Webhooks{},
+	}
+	Expect(cmd.InjectConfig(p.config())).To(Succeed())
+	Expect(cmd.InjectResource(res)).To(Succeed())
+	Expect(cmd.Scaffold(p.fs)).To(Succeed())
+}
+
+// copyFiles overwrites the given files of the project with their copies in dir, e.g. to add the user
+// code of a sample on top of the scaffolded project
+func (p *testProject) copyFiles(dir string, paths ...string) {
+	for _, path := range paths {
+		content, err := os.ReadFile(filepath.Join(dir, path))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(afero.WriteFile(p.fs.FS, path, content, 0o644)).To(Succeed())
+	}
+}
+
+// expectGolden saves the PROJECT file and compares the whole project with the given golden
+// directory, or replaces the golden directory with the project when -update is set
+func (p *testProject) expectGolden(goldenDir string) {
+	Expect(p.store.Save()).To(Succeed())
+	golden.ExpectTree(p.fs.FS, goldenDir)
+}
@@ -47,6 +47,8 @@ type initSubcommand struct {
 	domain      string
 	version     string
 	projectName string
+	multigroup  bool
+	helm        bool
 }
 
 var _ plugin.InitSubcommand = &initSubcommand{}
@@ -61,11 +63,22 @@ func (p *initSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *
   - a "Dockerfile" that helps containerizing the project
   - a "src/main.rs" file that runs controller reconcilers
   - a "src/controller.rs" file that provides a runner for controllers
+  - a "src/leader_election.rs" file that elects the replica running the controllers
+  - a "src/metrics.rs" file that serves the metrics and health probes of the controllers
+  - a "src/conditions.rs" file that helps maintaining the conditions in the status of the resources
   - a "src/crd_generator.rs" file helps generating CRDs
+  - a "config/manifests" directory with the base ClusterServiceVersion of the OLM bundle
+  - a Helm chart under "dist/chart" deploying the operator, if --helm is set
 `
 	subcmdMeta.Examples = fmt.Sprintf(`  # Initialize a new project with your domain and name in copyright
   %[1]s init --plugins rust/v1alpha --domain example.org --owner "Your name"
 
+  # Initialize a new project whose APIs are organized by group
+  %[1]s init --plugins rust/v1alpha --domain example.org --multigroup
+
+  # Initialize a new project that is also deployed with a Helm chart
+  %[1]s init --plugins rust/v1alpha --domain example.org --helm
+
   # Initialize a new project defining a specific project version
   %[1]s init --plugins rust/v1alpha --version 3
 `, cliMeta.CommandName)
@@ -76,16 +89,23 @@ func (p *initSubcommand) BindFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&p.domain, "domain", "my.domain", "domain for groups")
 	fs.StringVar(&p.projectName, "project-name", "", "name of this project, the default being directory name")
 	fs.StringVar(&p.version, "version", "", "resource version")
+	fs.BoolVar(&p.multigroup, multigroupFlag, false,
+		"if set, nest the types and the controllers of the APIs in the modules of their group")
+	fs.BoolVar(&p.helm, helmFlag, false,
+		"if set, scaffold a Helm chart under dist/chart to which the CRDs and the RBAC rules of the APIs are added")
 
 	// boilerplate args
-	fs.StringVar(&p.license, "license", "apache2",
-		"license to use to boilerplate, may be one of 'apache2', 'none'")
-	fs.StringVar(&p.owner, "owner", "", "owner to add to the copyright")
+	fs.StringVar(&p.license, licenseFlag, defaultLicense, licenseUsage)
+	fs.StringVar(&p.owner, ownerFlag, "", "owner to add to the copyright")
 }
 
 func (p *initSubcommand) InjectConfig(c config.Config) error {
 	p.config = c
 
+	if err := validateLicense(p.license); err != nil {
+		return err
+	}
+
 	if err := p.config.SetDomain(p.domain); err != nil {
 		return err
 	}
@@ -106,7 +126,15 @@ func (p *initSubcommand) InjectConfig(c config.Config) error {
 		return err
 	}
 
-	return nil
+	if p.multigroup {
+		if err := p.config.SetMultiGroup(); err != nil {
+			return err
+		}
+	}
+
+	// Track the boilerplate settings so that they can be changed later with the edit subcommand, and
+	// whether the APIs are added to the Helm chart
+	return encodePluginConfig(p.config, pluginConfig{License: p.license, Owner: p.owner, Helm: p.helm})
 }
 
 func (p *initSubcommand) PreScaffold(machinery.Filesystem) error {
@@ -115,7 +143,7 @@ func (p *initSubcommand) PreScaffold(machinery.Filesystem) error {
 }
 
 func (p *initSubcommand) Scaffold(fs machinery.Filesystem) error {
-	scaffolder := scaffolds.NewInitScaffolder(p.config, p.license, p.owner, p.commandName)
+	scaffolder := scaffolds.NewInitScaffolder(p.config, p.license, p.owner, p.commandName, p.helm)
 	scaffolder.InjectFS(fs)
 	err := scaffolder.Scaffold()
 	if err != nil {
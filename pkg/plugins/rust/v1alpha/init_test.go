@@ -39,6 +39,7 @@ var _ = Describe("Init test", func() {
 		successInitSubcommand = initSubcommand{
 			domain:      "testDomain",
 			commandName: "testCommand",
+			license:     "apache2",
 		}
 	})
 
@@ -61,6 +62,7 @@ var _ = Describe("Init test", func() {
 			Expect(successInitSubcommand.domain).To(Equal("my.domain"))
 			Expect(successInitSubcommand.projectName).To(Equal(""))
 			Expect(successInitSubcommand.version).To(Equal(""))
+			Expect(successInitSubcommand.helm).To(BeFalse())
 		})
 	})
 
@@ -75,6 +77,20 @@ var _ = Describe("Init test", func() {
 			Expect(successInitSubcommand.projectName).To(Equal(testConfig.GetProjectName()))
 			Expect(successInitSubcommand.InjectConfig(testConfig)).To(BeNil())
 		})
+
+		It("should accept the licenses supported by the edit subcommand", func() {
+			for _, license := range supportedLicenses {
+				testConfig, _ := config.New(config.Version{Number: 3})
+				successInitSubcommand.license = license
+				Expect(successInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+			}
+		})
+
+		It("should fail for an unsupported license", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			successInitSubcommand.license = "mit"
+			Expect(successInitSubcommand.InjectConfig(testConfig)).To(HaveOccurred())
+		})
 	})
 
 	Describe("PreScaffold", func() {
@@ -83,7 +99,9 @@ var _ = Describe("Init test", func() {
 			tmpDir, _ := os.MkdirTemp("", "test-dir-")
 			defer os.RemoveAll(tmpDir) // Clean up after the test
 
-			// Change the working directory to the temporary one
+			// Change the working directory to the temporary one and restore it afterwards
+			wd, _ := os.Getwd()
+			defer os.Chdir(wd) //nolint:errcheck
 			_ = os.Chdir(tmpDir)
 
 			Expect(successInitSubcommand.PreScaffold(machinery.Filesystem{})).To(BeNil())
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package golden compares the files scaffolded by the tests of the plugin with their golden copies,
+// and refreshes these copies instead when the tests are run with -update, e.g. by make update-golden.
+package golden
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"sort"
+
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+)
+
+// update refreshes the golden files instead of comparing against them, e.g.:
+// go test ./pkg/plugins/rust/v1alpha/... -update
+var update = flag.Bool("update", false, "update the golden files with the scaffolded output")
+
+// ExpectFiles compares the given files of fs with their copies in goldenDir, or refreshes these
+// copies when -update is set
+func ExpectFiles(fs afero.Fs, goldenDir string, paths ...string) {
+	for _, path := range paths {
+		actual, err := afero.ReadFile(fs, path)
+		Expect(err).NotTo(HaveOccurred())
+		goldenPath := filepath.Join(goldenDir, path)
+		if *update {
+			Expect(os.MkdirAll(filepath.Dir(goldenPath), 0o755)).To(Succeed())
+			Expect(os.WriteFile(goldenPath, actual, 0o644)).To(Succeed())
+			continue
+		}
+		expected, err := os.ReadFile(goldenPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(actual)).To(Equal(string(expected)),
+			"unexpected content in %s, run the tests with -update to refresh %s", path, goldenPath)
+	}
+}
+
+// ExpectTree compares every file of fs with goldenDir, or replaces goldenDir with these files when
+// -update is set
+func ExpectTree(fs afero.Fs, goldenDir string) {
+	actual := readTree(fs)
+
+	if *update {
+		Expect(os.RemoveAll(goldenDir)).To(Succeed())
+		for path, content := range actual {
+			goldenPath := filepath.Join(goldenDir, filepath.FromSlash(path))
+			Expect(os.MkdirAll(filepath.Dir(goldenPath), 0o755)).To(Succeed())
+			Expect(os.WriteFile(goldenPath, []byte(content), 0o644)).To(Succeed())
+		}
+		return
+	}
+
+	expected := readTree(afero.NewBasePathFs(afero.NewOsFs(), goldenDir))
+	Expect(keys(actual)).To(Equal(keys(expected)),
+		"the set of scaffolded files differs from %s, run the tests with -update to refresh it", goldenDir)
+	for path, content := range expected {
+		Expect(actual[path]).To(Equal(content),
+			"unexpected content in %s, run the tests with -update to refresh %s", path, goldenDir)
+	}
+}
+
+// readTree returns the content of every file of the given filesystem indexed by its path
+func readTree(fs afero.Fs) map[string]string {
+	files := make(map[string]string)
+	Expect(afero.Walk(fs, ".", func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		content, err := afero.ReadFile(fs, path)
+		files[filepath.ToSlash(path)] = string(content)
+		return err
+	})).To(Succeed())
+	return files
+}
+
+// keys returns the sorted keys of the given map
+func keys(m map[string]string) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 )
@@ -29,17 +30,59 @@ const pluginName = rust.DefaultNameQualifier
 var (
 	pluginVersion            = plugin.Version{Number: 1, Stage: stage.Alpha}
 	supportedProjectVersions = []config.Version{cfgv3.Version}
+	pluginKey                = plugin.KeyFor(Plugin{})
 )
 
 var (
-	_ plugin.Plugin    = Plugin{}
-	_ plugin.Init      = Plugin{}
-	_ plugin.CreateAPI = Plugin{}
+	_ plugin.Plugin        = Plugin{}
+	_ plugin.Init          = Plugin{}
+	_ plugin.CreateAPI     = Plugin{}
+	_ plugin.CreateWebhook = Plugin{}
+	_ plugin.Edit          = Plugin{}
 )
 
 type Plugin struct {
 	initSubcommand
 	createAPISubcommand
+	createWebhookSubcommand
+	editSubcommand
+}
+
+// pluginConfig contains the project settings tracked by this plugin in the PROJECT file
+type pluginConfig struct {
+	License string `json:"license,omitempty"`
+	Owner   string `json:"owner,omitempty"`
+	Image   string `json:"image,omitempty"`
+
+	// Helm indicates whether the project has a Helm chart, to which the APIs are added
+	Helm bool `json:"helm,omitempty"`
+
+	Resources []resourceConfig `json:"resources,omitempty"`
+}
+
+// resourceConfig contains the settings of a resource tracked by this plugin in the PROJECT file
+type resourceConfig struct {
+	resource.GVK
+
+	// Owns and Watches are the secondary kinds owned and watched by the controller of the resource
+	Owns    []string `json:"owns,omitempty"`
+	Watches []string `json:"watches,omitempty"`
+}
+
+// resourceConfig returns the tracked settings of the given resource, or nil
+func (cfg *pluginConfig) resourceConfig(gvk resource.GVK) *resourceConfig {
+	for i := range cfg.Resources {
+		if cfg.Resources[i].IsEqualTo(gvk) {
+			return &cfg.Resources[i]
+		}
+	}
+	return nil
+}
+
+// addResourceConfig starts tracking the settings of the given resource
+func (cfg *pluginConfig) addResourceConfig(gvk resource.GVK) *resourceConfig {
+	cfg.Resources = append(cfg.Resources, resourceConfig{GVK: gvk})
+	return &cfg.Resources[len(cfg.Resources)-1]
 }
 
 // Name returns the name of the plugin
@@ -57,6 +100,14 @@ func (p Plugin) GetInitSubcommand() plugin.InitSubcommand { return &p.initSubcom
 // GetCreateAPISubcommand will return the subcommand which is responsible for scaffolding apis
 func (p Plugin) GetCreateAPISubcommand() plugin.CreateAPISubcommand { return &p.createAPISubcommand }
 
+// GetCreateWebhookSubcommand will return the subcommand which is responsible for scaffolding webhooks
+func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
+	return &p.createWebhookSubcommand
+}
+
+// GetEditSubcommand will return the subcommand which is responsible for editing the project configuration
+func (p Plugin) GetEditSubcommand() plugin.EditSubcommand { return &p.editSubcommand }
+
 func (p Plugin) DeprecationWarning() string {
 	return ""
 }
@@ -49,4 +49,26 @@ var _ = Describe("Plugin Test", func() {
 			Expect(testPlugin.GetCreateAPISubcommand()).To(Equal(&testPlugin.createAPISubcommand))
 		})
 	})
+
+	Describe("GetCreateWebhookSubcommand", func() {
+		It("should return the correct plugin createWebhookSubcommand", func() {
+			Expect(testPlugin.GetCreateWebhookSubcommand()).To(Equal(&testPlugin.createWebhookSubcommand))
+		})
+	})
+
+	Describe("GetEditSubcommand", func() {
+		It("should return the correct plugin editSubcommand", func() {
+			Expect(testPlugin.GetEditSubcommand()).To(Equal(&testPlugin.editSubcommand))
+		})
+	})
+
+	Describe("NewBundle", func() {
+		It("should bundle the plugin under its own key", func() {
+			bundle, err := NewBundle()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plugin.KeyFor(bundle)).To(Equal(pluginKey))
+			Expect(bundle.Plugins()).To(Equal([]plugin.Plugin{Plugin{}}))
+			Expect(bundle.SupportedProjectVersions()).To(Equal(supportedProjectVersions))
+		})
+	})
 })
@@ -17,15 +17,27 @@ limitations under the License.
 package scaffolds
 
 import (
+	"errors"
 	"fmt"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/chart"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/config/crd"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/config/manifests"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/config/samples"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/hack"
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/src"
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/src/api"
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/src/controller"
+	"github.com/spf13/afero"
 	"log"
+	"os"
+	"path/filepath"
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"strings"
 )
 
 var _ plugins.Scaffolder = &apiScaffolder{}
@@ -41,14 +53,44 @@ type apiScaffolder struct {
 
 	// force indicates whether to scaffold controller files even if it exists or not
 	force bool
+
+	options APIOptions
+}
+
+// APIOptions holds the settings of the scaffolded API and controller which are not stored in the resource
+type APIOptions struct {
+	// Finalizer indicates whether the controller registers a finalizer to clean up before deletion
+	Finalizer bool
+
+	// Owns and Watches are the secondary kinds owned and watched by the controller
+	Owns    []rust.KindReference
+	Watches []rust.KindReference
+
+	// ShortNames, Categories and PrintColumns customize how kubectl shows the resource
+	ShortNames   []string
+	Categories   []string
+	PrintColumns []rust.PrintColumn
+
+	// SpecFields and StatusFields are the fields of the spec and the status of the resource
+	SpecFields   []rust.Field
+	StatusFields []rust.Field
+
+	// Helm indicates whether the project has a Helm chart, to which the CRD and the rules of the
+	// controller are added
+	Helm bool
+
+	// Namespaced indicates whether an external kind is namespace-scoped, as the scope of the kinds
+	// without an API in the project is not stored in the resource
+	Namespaced bool
 }
 
 // NewAPIScaffolder returns a new Scaffolder for API/controller creation operations
-func NewAPIScaffolder(config config.Config, res resource.Resource, force bool) plugins.Scaffolder {
+func NewAPIScaffolder(config config.Config, res resource.Resource, force bool, options APIOptions) plugins.Scaffolder {
 	return &apiScaffolder{
 		config:   config,
 		resource: res,
 		force:    force,
+		options:  options,
 	}
 }
 
@@ -60,23 +102,45 @@ func (s *apiScaffolder) InjectFS(fs machinery.Filesystem) {
 func (s *apiScaffolder) Scaffold() error {
 	log.Println("Writing scaffold for you to edit...")
 
+	// Load the boilerplate, which is missing if the project was initialized without a license
+	boilerplate, err := afero.ReadFile(s.fs.FS, hack.DefaultBoilerplatePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error scaffolding API/controller: unable to load boilerplate: %w", err)
+	}
+
 	// Initialize the machinery.Scaffold that will write the files to disk
 	scaffold := machinery.NewScaffold(s.fs,
 		machinery.WithConfig(s.config),
+		machinery.WithBoilerplate(string(boilerplate)),
 		machinery.WithResource(&s.resource),
 	)
 
 	// Keep track of these values before the update
 	doAPI := s.resource.HasAPI()
 	doController := s.resource.HasController()
+	storageVersion := s.storageVersion()
 
 	if err := s.config.UpdateResource(s.resource); err != nil {
 		return fmt.Errorf("error updating resource: %w", err)
 	}
 
+	// Use the stored resource so that previously scaffolded API details (e.g. scope) are known
+	res, err := s.config.GetResource(s.resource.GVK)
+	if err != nil {
+		return fmt.Errorf("error getting resource: %w", err)
+	}
+	s.resource = res
+
 	if doAPI {
 		if err := scaffold.Execute(
-			&api.Types{Force: s.force},
+			&api.Types{
+				Force:        s.force,
+				ShortNames:   s.options.ShortNames,
+				Categories:   s.options.Categories,
+				PrintColumns: s.options.PrintColumns,
+				SpecFields:   s.options.SpecFields,
+				StatusFields: s.options.StatusFields,
+			},
 		); err != nil {
 			return fmt.Errorf("error scaffolding APIs: %v", err)
 		}
@@ -87,16 +151,72 @@ func (s *apiScaffolder) Scaffold() error {
 			return fmt.Errorf("error updating src/api.rs: %v", err)
 		}
 
+		if err := s.scaffoldAPIModules(scaffold); err != nil {
+			return err
+		}
+
 		if err := scaffold.Execute(
-			&src.CRDGeneratorUpdater{WireResource: doAPI, WireController: doController},
+			&src.CRDGeneratorUpdater{
+				WireResource:   doAPI,
+				WireController: doController,
+				StorageVersion: storageVersion == s.resource.Version,
+			},
 		); err != nil {
 			return fmt.Errorf("error updating src/crd_generator.rs: %v", err)
 		}
+
+		if err := scaffold.Execute(
+			&crd.KustomizationUpdater{},
+		); err != nil {
+			return fmt.Errorf("error updating config/crd/kustomization.yaml: %v", err)
+		}
+
+		if err := scaffold.Execute(
+			&templates.MakefileUpdater{WireResource: doAPI, Helm: s.options.Helm},
+		); err != nil {
+			return fmt.Errorf("error updating Makefile: %v", err)
+		}
+
+		// The projects initialized before the OLM bundle was supported have no ClusterServiceVersion
+		csvExists, err := afero.Exists(s.fs.FS, manifests.CSVPath(s.config.GetProjectName()))
+		if err != nil {
+			return fmt.Errorf("error updating the ClusterServiceVersion: %v", err)
+		}
+		if csvExists {
+			if err := scaffold.Execute(
+				&manifests.CSVUpdater{WireResource: doAPI},
+			); err != nil {
+				return fmt.Errorf("error updating the ClusterServiceVersion: %v", err)
+			}
+		}
+
+		if err := scaffold.Execute(
+			&samples.CRDSample{Force: s.force, SpecFields: s.options.SpecFields},
+			&samples.KustomizationUpdater{},
+		); err != nil {
+			return fmt.Errorf("error scaffolding sample: %v", err)
+		}
 	}
 
 	if doController {
+		owns, err := s.relationships(s.options.Owns)
+		if err != nil {
+			return fmt.Errorf("error scaffolding controller: %v", err)
+		}
+		watches, err := s.relationships(s.options.Watches)
+		if err != nil {
+			return fmt.Errorf("error scaffolding controller: %v", err)
+		}
+
 		if err := scaffold.Execute(
-			&controller.Controllers{Force: s.force},
+			&controller.Controllers{
+				Force:      s.force,
+				Namespaced: s.namespaced(),
+				Finalizer:  s.options.Finalizer,
+				Status:     s.resource.HasAPI(),
+				Owns:       owns,
+				Watches:    watches,
+			},
 		); err != nil {
 			return fmt.Errorf("error scaffolding controller: %v", err)
 		}
@@ -107,8 +227,28 @@ func (s *apiScaffolder) Scaffold() error {
 			return fmt.Errorf("error updating src/controller.rs: %v", err)
 		}
 
+		// The controllers are nested in the module of their group in the multigroup layout
+		if s.config.IsMultiGroup() {
+			kind := strings.ToLower(s.resource.Kind)
+			if err := scaffoldModule(scaffold, rust.ControllerDir(s.resource.GVK, true), kind+"_controller"); err != nil {
+				return err
+			}
+		}
+
+		if s.options.Helm {
+			if err := scaffold.Execute(
+				&chart.RoleUpdater{
+					Namespaced:     s.namespaced(),
+					Rules:          chartRules(owns, watches),
+					WireController: doController,
+				},
+			); err != nil {
+				return fmt.Errorf("error updating the role of the Helm chart: %v", err)
+			}
+		}
+
 		if err := scaffold.Execute(
-			&src.MainUpdater{WireResource: doAPI, WireController: doController},
+			&src.MainUpdater{WireResource: doAPI, WireController: doController, Namespaced: s.namespaced()},
 		); err != nil {
 			return fmt.Errorf("error updating src/main.rs: %v", err)
 		}
@@ -116,3 +256,148 @@ func (s *apiScaffolder) Scaffold() error {
 
 	return nil
 }
+
+// scaffoldAPIModules declares the modules of the types of the resource, nested in the modules of its
+// version and, in the multigroup layout, of its group
+func (s *apiScaffolder) scaffoldAPIModules(scaffold *machinery.Scaffold) error {
+	apiDir := rust.APIDir(s.resource.GVK, s.config.IsMultiGroup())
+	if err := scaffoldModule(scaffold, apiDir, strings.ToLower(s.resource.Kind)+"_types"); err != nil {
+		return err
+	}
+
+	if s.config.IsMultiGroup() {
+		return scaffoldModule(scaffold, filepath.Dir(apiDir), s.resource.Version)
+	}
+	return nil
+}
+
+// scaffoldModule declares the given modules in the mod.rs file of a directory, creating it if needed
+func scaffoldModule(scaffold *machinery.Scaffold, dir string, modules ...string) error {
+	path := filepath.Join(dir, "mod.rs")
+	module := &src.Module{}
+	module.Path = path
+	if err := scaffold.Execute(
+		module,
+		&src.ModuleUpdater{Path: path, Modules: modules},
+	); err != nil {
+		return fmt.Errorf("error updating %s: %v", path, err)
+	}
+	return nil
+}
+
+// chartRules returns the rules of the Helm chart granting the controller access to its secondary kinds
+func chartRules(owns, watches []controller.Relationship) []chart.Rule {
+	rules := make([]chart.Rule, 0, len(owns)+len(watches))
+	for _, relationship := range owns {
+		rules = append(rules, chartRule(relationship, "get", "list", "watch", "create", "update", "patch", "delete"))
+	}
+	for _, relationship := range watches {
+		rules = append(rules, chartRule(relationship, "get", "list", "watch"))
+	}
+	return rules
+}
+
+func chartRule(relationship controller.Relationship, verbs ...string) chart.Rule {
+	return chart.Rule{
+		Group:      chart.RuleGroup(relationship.Group),
+		Resource:   relationship.Plural,
+		Verbs:      verbs,
+		Namespaced: relationship.Namespaced,
+	}
+}
+
+// storageVersion returns the version the kind of the resource is stored in, which is the first of its
+// versions with an API in the project, or the version of the resource if there is none
+func (s *apiScaffolder) storageVersion() string {
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return s.resource.Version
+	}
+	for _, res := range resources {
+		if res.Group == s.resource.Group && res.Domain == s.resource.Domain && res.Kind == s.resource.Kind &&
+			res.HasAPI() {
+			return res.Version
+		}
+	}
+	return s.resource.Version
+}
+
+// namespaced returns whether the reconciled kind is namespace-scoped, which is known for the APIs of
+// the project and the built-in kinds, and given by the options for the external ones
+func (s *apiScaffolder) namespaced() bool {
+	if s.resource.HasAPI() {
+		return s.resource.API.Namespaced
+	}
+	if strings.HasPrefix(s.resource.Path, "k8s_openapi::") {
+		return !rust.KindReference{Kind: s.resource.Kind}.IsClusterScoped()
+	}
+	return s.options.Namespaced
+}
+
+// relationships resolves the Rust types of the given secondary kinds, which are either APIs of the
+// project or built-in Kubernetes kinds
+func (s *apiScaffolder) relationships(kinds []rust.KindReference) ([]controller.Relationship, error) {
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resources: %w", err)
+	}
+
+	relationships := make([]controller.Relationship, 0, len(kinds))
+	for _, kind := range kinds {
+		relationship, err := s.relationship(kind, resources)
+		if err != nil {
+			return nil, err
+		}
+		if kind.QualifiedGroup == s.resource.QualifiedGroup() &&
+			kind.Version == s.resource.Version && kind.Kind == s.resource.Kind {
+			return nil, fmt.Errorf("the controller of %s cannot own or watch its own kind", kind)
+		}
+		relationships = append(relationships, relationship)
+	}
+	return relationships, nil
+}
+
+func (s *apiScaffolder) relationship(kind rust.KindReference, resources []resource.Resource) (
+	controller.Relationship, error) {
+	for _, res := range resources {
+		if res.QualifiedGroup() != kind.QualifiedGroup || res.Version != kind.Version || res.Kind != kind.Kind {
+			continue
+		}
+		if res.HasAPI() {
+			return controller.Relationship{
+				Kind:       res.Kind,
+				Type:       fmt.Sprintf("%s::%s", rust.APIModule(res.GVK, s.config.IsMultiGroup()), res.Kind),
+				Group:      res.QualifiedGroup(),
+				Plural:     res.Plural,
+				Namespaced: res.API.Namespaced,
+			}, nil
+		}
+		// The external kinds of the project are assumed to be namespaced, as their scope is not stored
+		if res.Path != "" && !strings.HasPrefix(res.Path, "k8s_openapi::") {
+			return controller.Relationship{
+				Kind:       res.Kind,
+				Type:       fmt.Sprintf("%s::%s", res.Path, res.Kind),
+				Group:      res.QualifiedGroup(),
+				Plural:     res.Plural,
+				Namespaced: true,
+			}, nil
+		}
+	}
+
+	module, builtin := kind.BuiltinModule()
+	if !builtin {
+		return controller.Relationship{}, fmt.Errorf(
+			"%s is neither an API of the project nor a built-in Kubernetes kind", kind)
+	}
+	group := kind.QualifiedGroup
+	if group == "" {
+		group = "core"
+	}
+	return controller.Relationship{
+		Kind:       kind.Kind,
+		Type:       fmt.Sprintf("%s::%s", module, kind.Kind),
+		Group:      group,
+		Plural:     kind.Plural(),
+		Namespaced: !kind.IsClusterScoped(),
+	}, nil
+}
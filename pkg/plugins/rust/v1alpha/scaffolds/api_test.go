@@ -0,0 +1,407 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("API scaffolder", func() {
+	var (
+		fs  machinery.Filesystem
+		cfg config.Config
+	)
+
+	BeforeEach(func() {
+		fs, cfg = initTestProject()
+	})
+
+	scaffoldAPI := func(res resource.Resource, options APIOptions) {
+		apiScaffolder := NewAPIScaffolder(cfg, res, false, options)
+		apiScaffolder.InjectFS(fs)
+		Expect(apiScaffolder.Scaffold()).To(Succeed())
+	}
+
+	It("should scaffold a namespaced resource", func() {
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "cache",
+				Domain:  "example.com",
+				Version: "v1alpha1",
+				Kind:    "Memcached",
+			},
+			Plural:     "memcacheds",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		}, APIOptions{})
+
+		expectGolden(fs, "namespaced",
+			filepath.Join("src", "api", "v1alpha1", "memcached_types.rs"),
+			filepath.Join("src", "controller.rs"),
+			filepath.Join("src", "controller", "memcached_controller.rs"),
+			filepath.Join("src", "crd_generator.rs"),
+			filepath.Join("config", "crd", "kustomization.yaml"),
+			filepath.Join("config", "samples", "kustomization.yaml"),
+			filepath.Join("config", "samples", "cache_v1alpha1_memcached.yaml"),
+		)
+	})
+
+	It("should scaffold a cluster-scoped resource", func() {
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "tenancy",
+				Domain:  "example.com",
+				Version: "v1",
+				Kind:    "Tenant",
+			},
+			Plural:     "tenants",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: false},
+			Controller: true,
+		}, APIOptions{})
+
+		expectGolden(fs, "cluster",
+			filepath.Join("src", "api", "v1", "tenant_types.rs"),
+			filepath.Join("src", "controller.rs"),
+			filepath.Join("src", "controller", "tenant_controller.rs"),
+		)
+	})
+
+	It("should scaffold a resource customized for kubectl", func() {
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "ship",
+				Domain:  "example.com",
+				Version: "v1",
+				Kind:    "Cactus",
+			},
+			Plural:     "cactuses",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		}, APIOptions{
+			ShortNames: []string{"cac", "cs"},
+			Categories: []string{"all"},
+			PrintColumns: []rust.PrintColumn{
+				{Name: "Replicas", Type: "integer", JSONPath: ".spec.replicas"},
+				{Name: "Ready", Type: "string", JSONPath: `.status.conditions[?(@.type=="Ready")].status`},
+			},
+		})
+
+		expectGolden(fs, "kubectl",
+			filepath.Join("src", "api", "v1", "cactus_types.rs"),
+		)
+	})
+
+	It("should scaffold the fields of the spec and the status", func() {
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "ship",
+				Domain:  "example.com",
+				Version: "v1",
+				Kind:    "Frigate",
+			},
+			Plural:     "frigates",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		}, APIOptions{
+			SpecFields: []rust.Field{
+				{Name: "image", Type: "string", Required: true},
+				{Name: "replicas", Type: "integer"},
+				{Name: "container_ports", Type: "[]int32", Required: true},
+				{Name: "nodeSelector", Type: "map[string]string"},
+				{Name: "type", Type: "string"},
+			},
+			StatusFields: []rust.Field{
+				{Name: "readyReplicas", Type: "int64"},
+				{Name: "healthy", Type: "boolean", Required: true},
+			},
+		})
+
+		expectGolden(fs, "fields",
+			filepath.Join("src", "api", "v1", "frigate_types.rs"),
+			filepath.Join("config", "samples", "ship_v1_frigate.yaml"),
+		)
+	})
+
+	It("should scaffold a second version of a kind stored in the first one", func() {
+		memcached := func(version string) resource.Resource {
+			return resource.Resource{
+				GVK: resource.GVK{
+					Group:   "cache",
+					Domain:  "example.com",
+					Version: version,
+					Kind:    "Memcached",
+				},
+				Plural: "memcacheds",
+				API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+			}
+		}
+		scaffoldAPI(memcached("v1alpha1"), APIOptions{})
+		scaffoldAPI(memcached("v1"), APIOptions{})
+
+		exists, err := afero.Exists(fs.FS, filepath.Join("src", "api", "v1", "memcached_conversion.rs"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		expectGolden(fs, "multiversion",
+			filepath.Join("src", "api.rs"),
+			filepath.Join("src", "api", "v1", "mod.rs"),
+			filepath.Join("src", "api", "v1alpha1", "mod.rs"),
+			filepath.Join("src", "crd_generator.rs"),
+			filepath.Join("config", "crd", "kustomization.yaml"),
+			filepath.Join("config", "manifests", "bases", "test-operator.clusterserviceversion.yaml"),
+		)
+	})
+
+	It("should scaffold a controller cleaning up through a finalizer", func() {
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "cache",
+				Domain:  "example.com",
+				Version: "v1alpha1",
+				Kind:    "Memcached",
+			},
+			Plural:     "memcacheds",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		}, APIOptions{Finalizer: true})
+
+		expectGolden(fs, "finalizer",
+			filepath.Join("src", "controller", "memcached_controller.rs"),
+		)
+	})
+
+	It("should scaffold a cluster-scoped controller cleaning up through a finalizer", func() {
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "tenancy",
+				Domain:  "example.com",
+				Version: "v1",
+				Kind:    "Tenant",
+			},
+			Plural:     "tenants",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: false},
+			Controller: true,
+		}, APIOptions{Finalizer: true})
+
+		expectGolden(fs, "finalizer",
+			filepath.Join("src", "controller", "tenant_controller.rs"),
+		)
+	})
+
+	It("should scaffold a controller owning and watching secondary kinds", func() {
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "cache",
+				Domain:  "example.com",
+				Version: "v1alpha1",
+				Kind:    "Memcached",
+			},
+			Plural:     "memcacheds",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		}, APIOptions{
+			Finalizer: true,
+			Owns: []rust.KindReference{
+				{QualifiedGroup: "apps", Version: "v1", Kind: "Deployment"},
+				{QualifiedGroup: "networking.k8s.io", Version: "v1", Kind: "Ingress"},
+			},
+			Watches: []rust.KindReference{
+				{Version: "v1", Kind: "ConfigMap"},
+				{Version: "v1", Kind: "Namespace"},
+			},
+		})
+
+		expectGolden(fs, "relationships",
+			filepath.Join("src", "controller", "memcached_controller.rs"),
+		)
+	})
+
+	It("should fail to scaffold a controller watching an unknown kind", func() {
+		apiScaffolder := NewAPIScaffolder(cfg, resource.Resource{
+			GVK: resource.GVK{
+				Group:   "cache",
+				Domain:  "example.com",
+				Version: "v1alpha1",
+				Kind:    "Memcached",
+			},
+			Plural:     "memcacheds",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		}, false, APIOptions{
+			Watches: []rust.KindReference{{QualifiedGroup: "ship.example.com", Version: "v1", Kind: "Frigate"}},
+		})
+		apiScaffolder.InjectFS(fs)
+		Expect(apiScaffolder.Scaffold()).To(MatchError(ContainSubstring(
+			"ship.example.com/v1/Frigate is neither an API of the project nor a built-in Kubernetes kind")))
+	})
+
+	It("should fail to scaffold a controller owning its own kind", func() {
+		apiScaffolder := NewAPIScaffolder(cfg, resource.Resource{
+			GVK: resource.GVK{
+				Group:   "cache",
+				Domain:  "example.com",
+				Version: "v1alpha1",
+				Kind:    "Memcached",
+			},
+			Plural:     "memcacheds",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		}, false, APIOptions{
+			Owns: []rust.KindReference{{QualifiedGroup: "cache.example.com", Version: "v1alpha1", Kind: "Memcached"}},
+		})
+		apiScaffolder.InjectFS(fs)
+		Expect(apiScaffolder.Scaffold()).To(MatchError(ContainSubstring("cannot own or watch its own kind")))
+	})
+
+	It("should scaffold a controller for a built-in kind", func() {
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "apps",
+				Version: "v1",
+				Kind:    "Deployment",
+			},
+			Plural:     "deployments",
+			Path:       "k8s_openapi::api::apps::v1",
+			Controller: true,
+		}, APIOptions{})
+
+		expectGolden(fs, "builtin",
+			filepath.Join("src", "controller", "deployment_controller.rs"),
+			filepath.Join("src", "main.rs"),
+		)
+	})
+
+	It("should scaffold a controller for a cluster-scoped built-in kind", func() {
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "core",
+				Version: "v1",
+				Kind:    "Namespace",
+			},
+			Plural:     "namespaces",
+			Path:       "k8s_openapi::api::core::v1",
+			Controller: true,
+		}, APIOptions{Namespaced: true})
+
+		expectGolden(fs, "builtin-cluster",
+			filepath.Join("src", "controller", "namespace_controller.rs"),
+			filepath.Join("src", "main.rs"),
+		)
+	})
+
+	It("should scaffold a controller for an external kind", func() {
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "cert-manager",
+				Domain:  "io",
+				Version: "v1",
+				Kind:    "Certificate",
+			},
+			Plural:     "certificates",
+			Path:       "cert_manager_api::v1",
+			Controller: true,
+		}, APIOptions{Namespaced: true})
+
+		expectGolden(fs, "external",
+			filepath.Join("src", "controller", "certificate_controller.rs"),
+			filepath.Join("src", "main.rs"),
+		)
+	})
+
+	It("should add the CRD and the rules of the controller to the Helm chart", func() {
+		fs, cfg = initTestProjectWithHelm(true)
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "cache",
+				Domain:  "example.com",
+				Version: "v1alpha1",
+				Kind:    "Memcached",
+			},
+			Plural:     "memcacheds",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		}, APIOptions{
+			Helm:    true,
+			Owns:    []rust.KindReference{{QualifiedGroup: "apps", Version: "v1", Kind: "Deployment"}},
+			Watches: []rust.KindReference{{Version: "v1", Kind: "ConfigMap"}},
+		})
+
+		expectGolden(fs, "helm",
+			"Makefile",
+			filepath.Join("dist", "chart", "Chart.yaml"),
+			filepath.Join("dist", "chart", "values.yaml"),
+			filepath.Join("dist", "chart", "templates", "_helpers.tpl"),
+			filepath.Join("dist", "chart", "templates", "manager", "manager.yaml"),
+			filepath.Join("dist", "chart", "templates", "rbac", "service_account.yaml"),
+			filepath.Join("dist", "chart", "templates", "rbac", "role.yaml"),
+			filepath.Join("dist", "chart", "templates", "rbac", "leader_election_role.yaml"),
+		)
+	})
+
+	It("should add the rules of a controller for a core built-in kind to the Helm chart", func() {
+		fs, cfg = initTestProjectWithHelm(true)
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "core",
+				Version: "v1",
+				Kind:    "ConfigMap",
+			},
+			Plural:     "configmaps",
+			Path:       "k8s_openapi::api::core::v1",
+			Controller: true,
+		}, APIOptions{Namespaced: true, Helm: true})
+
+		expectGolden(fs, "helm-builtin",
+			"Makefile",
+			filepath.Join("dist", "chart", "templates", "rbac", "role.yaml"),
+		)
+	})
+
+	It("should grant the rules on cluster-scoped kinds through a ClusterRole of the Helm chart", func() {
+		fs, cfg = initTestProjectWithHelm(true)
+		scaffoldAPI(resource.Resource{
+			GVK: resource.GVK{
+				Group:   "tenancy",
+				Domain:  "example.com",
+				Version: "v1",
+				Kind:    "Tenant",
+			},
+			Plural:     "tenants",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: false},
+			Controller: true,
+		}, APIOptions{
+			Helm:    true,
+			Owns:    []rust.KindReference{{Version: "v1", Kind: "Namespace"}},
+			Watches: []rust.KindReference{{Version: "v1", Kind: "ConfigMap"}},
+		})
+
+		expectGolden(fs, "helm-cluster",
+			filepath.Join("src", "controller", "tenant_controller.rs"),
+			filepath.Join("dist", "chart", "templates", "rbac", "role.yaml"),
+		)
+	})
+})
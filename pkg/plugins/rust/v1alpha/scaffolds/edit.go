@@ -0,0 +1,231 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/hack"
+	"github.com/spf13/afero"
+	"os"
+	"regexp"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"strings"
+)
+
+const (
+	cargoTomlPath = "Cargo.toml"
+	makefilePath  = "Makefile"
+)
+
+var _ plugins.Scaffolder = &editScaffolder{}
+
+// EditOptions contains the project settings to change, nil fields are left untouched
+type EditOptions struct {
+	Multigroup *bool
+	License    *string
+	Owner      *string
+	Image      *string
+}
+
+type editScaffolder struct {
+	config  config.Config
+	options EditOptions
+
+	// license and owner are the resulting boilerplate settings of the project
+	license string
+	owner   string
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewEditScaffolder returns a new Scaffolder for configuration edit operations.
+// The license and owner are the project settings after the edit, used to render the boilerplate.
+func NewEditScaffolder(config config.Config, options EditOptions, license, owner string) plugins.Scaffolder {
+	return &editScaffolder{
+		config:  config,
+		options: options,
+		license: license,
+		owner:   owner,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *editScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *editScaffolder) Scaffold() error {
+	if s.options.Multigroup != nil {
+		var err error
+		if *s.options.Multigroup {
+			err = s.config.SetMultiGroup()
+		} else {
+			err = s.config.ClearMultiGroup()
+		}
+		if err != nil {
+			return fmt.Errorf("error updating multigroup layout: %w", err)
+		}
+	}
+
+	if s.options.License != nil || s.options.Owner != nil {
+		if err := s.updateBoilerplate(); err != nil {
+			return fmt.Errorf("error updating %s: %w", hack.DefaultBoilerplatePath, err)
+		}
+
+		if err := s.updateFile(cargoTomlPath, func(content string) string {
+			content = setCargoPackageKey(content, "license", tomlString(templates.CargoLicense(s.license)))
+			return setCargoPackageKey(content, "authors", tomlArray(s.owner))
+		}); err != nil {
+			return fmt.Errorf("error updating %s: %w", cargoTomlPath, err)
+		}
+	}
+
+	if s.options.Image != nil {
+		if err := s.updateFile(makefilePath, func(content string) string {
+			return makefileImageRegexp.ReplaceAllLiteralString(content, "IMG ?= "+*s.options.Image)
+		}); err != nil {
+			return fmt.Errorf("error updating %s: %w", makefilePath, err)
+		}
+	}
+
+	return nil
+}
+
+// boilerplateOwnerRegexp matches the owner in the copyright line of the boilerplate file
+var boilerplateOwnerRegexp = regexp.MustCompile(`(?m)^Copyright \d+ (.+)\.$`)
+
+// DetectBoilerplate returns the license and owner of the boilerplate file of a project which does not
+// track them in the PROJECT file, e.g. a project initialized before they were tracked
+func DetectBoilerplate(fs machinery.Filesystem) (license, owner string, err error) {
+	content, err := afero.ReadFile(fs.FS, hack.DefaultBoilerplatePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return "none", "", nil
+	} else if err != nil {
+		return "", "", fmt.Errorf("error reading %s: %w", hack.DefaultBoilerplatePath, err)
+	}
+
+	license = "copyright"
+	if strings.Contains(string(content), "Licensed under the Apache License") {
+		license = "apache2"
+	}
+	if match := boilerplateOwnerRegexp.FindStringSubmatch(string(content)); match != nil {
+		owner = match[1]
+	}
+	return license, owner, nil
+}
+
+// updateBoilerplate re-renders the boilerplate file, or removes it when the project has no license
+func (s *editScaffolder) updateBoilerplate() error {
+	if s.license == "none" {
+		err := s.fs.FS.Remove(hack.DefaultBoilerplatePath)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+
+	bpFile := &hack.Boilerplate{
+		License: s.license,
+		Owner:   s.owner,
+	}
+	bpFile.IfExistsAction = machinery.OverwriteFile
+
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+	)
+	return scaffold.Execute(bpFile)
+}
+
+// updateFile applies the given patch to the content of a scaffolded file
+func (s *editScaffolder) updateFile(path string, patch func(string) string) error {
+	content, err := afero.ReadFile(s.fs.FS, path)
+	if err != nil {
+		return err
+	}
+
+	info, err := s.fs.FS.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	return afero.WriteFile(s.fs.FS, path, []byte(patch(string(content))), info.Mode())
+}
+
+var makefileImageRegexp = regexp.MustCompile(`(?m)^IMG \?= .*$`)
+
+// setCargoPackageKey sets the value of a key in the [package] table of a Cargo.toml,
+// removing the key if the value is empty
+func setCargoPackageKey(content, key, value string) string {
+	lines := strings.Split(content, "\n")
+
+	inPackage := false
+	lastPackageLine := -1
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") {
+			if inPackage {
+				break
+			}
+			inPackage = trimmed == "[package]"
+			continue
+		}
+		if !inPackage {
+			continue
+		}
+
+		if name, _, found := strings.Cut(trimmed, "="); found && strings.TrimSpace(name) == key {
+			if value == "" {
+				return strings.Join(append(lines[:i], lines[i+1:]...), "\n")
+			}
+			lines[i] = fmt.Sprintf("%s = %s", key, value)
+			return strings.Join(lines, "\n")
+		}
+		if trimmed != "" {
+			lastPackageLine = i
+		}
+	}
+
+	if value == "" || lastPackageLine == -1 {
+		return content
+	}
+
+	line := fmt.Sprintf("%s = %s", key, value)
+	lines = append(lines[:lastPackageLine+1], append([]string{line}, lines[lastPackageLine+1:]...)...)
+	return strings.Join(lines, "\n")
+}
+
+// tomlString returns the TOML string for the value, or an empty string for an empty value
+func tomlString(value string) string {
+	if value == "" {
+		return ""
+	}
+	return templates.TomlString(value)
+}
+
+// tomlArray returns the TOML array holding the value, or an empty string for an empty value
+func tomlArray(value string) string {
+	if value == "" {
+		return ""
+	}
+	return fmt.Sprintf("[%s]", templates.TomlString(value))
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ = Describe("Edit scaffolder", func() {
+	stringPtr := func(s string) *string { return &s }
+
+	It("should update the license and owner", func() {
+		fs, cfg := initTestProject()
+
+		editScaffolder := NewEditScaffolder(cfg, EditOptions{Owner: stringPtr("Test Owner")}, "apache2", "Test Owner")
+		editScaffolder.InjectFS(fs)
+		Expect(editScaffolder.Scaffold()).To(Succeed())
+
+		boilerplate, err := afero.ReadFile(fs.FS, filepath.Join("hack", "boilerplate.rs.txt"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(boilerplate)).To(MatchRegexp(`Copyright \d+ Test Owner\.`))
+		Expect(string(boilerplate)).To(ContainSubstring("Licensed under the Apache License"))
+
+		cargoToml, err := afero.ReadFile(fs.FS, "Cargo.toml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(cargoToml)).To(ContainSubstring(`license = "Apache-2.0"
+authors = ["Test Owner"]
+`))
+	})
+
+	It("should escape the owner in Cargo.toml the same way as init", func() {
+		const owner = `Test "Owner" \ Co`
+		const authors = `authors = ["Test \"Owner\" \\ Co"]`
+
+		initFs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+		initScaffolder := NewInitScaffolder(cfgv3.New(), "apache2", owner, "operator-sdk", false)
+		initScaffolder.InjectFS(initFs)
+		Expect(initScaffolder.Scaffold()).To(Succeed())
+
+		cargoToml, err := afero.ReadFile(initFs.FS, "Cargo.toml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(cargoToml)).To(ContainSubstring(authors + "\n"))
+
+		fs, cfg := initTestProject()
+		editScaffolder := NewEditScaffolder(cfg, EditOptions{Owner: stringPtr(owner)}, "apache2", owner)
+		editScaffolder.InjectFS(fs)
+		Expect(editScaffolder.Scaffold()).To(Succeed())
+
+		cargoToml, err = afero.ReadFile(fs.FS, "Cargo.toml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(cargoToml)).To(ContainSubstring(authors + "\n"))
+	})
+
+	It("should remove the license", func() {
+		fs, cfg := initTestProject()
+
+		editScaffolder := NewEditScaffolder(cfg, EditOptions{License: stringPtr("none")}, "none", "")
+		editScaffolder.InjectFS(fs)
+		Expect(editScaffolder.Scaffold()).To(Succeed())
+
+		exists, err := afero.Exists(fs.FS, filepath.Join("hack", "boilerplate.rs.txt"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		cargoToml, err := afero.ReadFile(fs.FS, "Cargo.toml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(cargoToml)).NotTo(ContainSubstring("license ="))
+		Expect(string(cargoToml)).NotTo(ContainSubstring("authors ="))
+	})
+
+	It("should update the image of the Makefile", func() {
+		fs, cfg := initTestProject()
+
+		editScaffolder := NewEditScaffolder(cfg, EditOptions{Image: stringPtr("example.com/test:v1")}, "apache2", "")
+		editScaffolder.InjectFS(fs)
+		Expect(editScaffolder.Scaffold()).To(Succeed())
+
+		makefile, err := afero.ReadFile(fs.FS, "Makefile")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(makefile)).To(HavePrefix("# Image URL to use for all building/pushing image targets\n" +
+			"IMG ?= example.com/test:v1\n"))
+	})
+})
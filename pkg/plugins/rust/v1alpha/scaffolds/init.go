@@ -20,6 +20,13 @@ import (
 	"errors"
 	"fmt"
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/chart"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/config/crd"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/config/kdefault"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/config/manager"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/config/manifests"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/config/rbac"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/config/samples"
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/hack"
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/src"
 	log "github.com/sirupsen/logrus"
@@ -38,18 +45,22 @@ type initScaffolder struct {
 	owner           string
 	commandName     string
 
+	// helm indicates whether to scaffold a Helm chart deploying the operator
+	helm bool
+
 	// fs is the filesystem that will be used by the scaffolder
 	fs machinery.Filesystem
 }
 
 // NewInitScaffolder returns a new plugins.Scaffolder for project initialization operations
-func NewInitScaffolder(config config.Config, license, owner, commandName string) plugins.Scaffolder {
+func NewInitScaffolder(config config.Config, license, owner, commandName string, helm bool) plugins.Scaffolder {
 	return &initScaffolder{
 		config:          config,
 		boilerplatePath: hack.DefaultBoilerplatePath,
 		license:         license,
 		owner:           owner,
 		commandName:     commandName,
+		helm:            helm,
 	}
 }
 
@@ -99,16 +110,48 @@ func (s *initScaffolder) Scaffold() error {
 		)
 	}
 
-	return scaffold.Execute(
+	if err := scaffold.Execute(
 		&src.Main{},
 		&src.Api{},
 		&src.Controller{},
+		&src.LeaderElection{},
+		&src.Metrics{},
+		&src.Conditions{},
 		&src.CRDGenerator{},
-		&templates.CargoToml{},
+		&src.RBACGenerator{},
+		&templates.CargoToml{License: s.license, Owner: s.owner},
 		&templates.GitIgnore{},
-		&templates.Makefile{},
+		&templates.Makefile{Helm: s.helm},
 		&templates.Dockerfile{},
 		&templates.DockerIgnore{},
 		&templates.Readme{},
+		&kdefault.Kustomization{},
+		&manager.Kustomization{},
+		&manager.Config{},
+		&rbac.Kustomization{},
+		&rbac.ServiceAccount{},
+		&rbac.Role{},
+		&rbac.RoleBinding{},
+		&rbac.LeaderElectionRole{},
+		&rbac.LeaderElectionRoleBinding{},
+		&crd.Kustomization{},
+		&samples.Kustomization{},
+		&manifests.Kustomization{},
+		&manifests.CSV{},
+	); err != nil {
+		return err
+	}
+
+	if !s.helm {
+		return nil
+	}
+	return scaffold.Execute(
+		&chart.Chart{},
+		&chart.Values{},
+		&chart.Helpers{},
+		&chart.Manager{},
+		&chart.ServiceAccount{},
+		&chart.Role{},
+		&chart.LeaderElectionRole{},
 	)
 }
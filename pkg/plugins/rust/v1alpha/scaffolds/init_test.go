@@ -0,0 +1,50 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("Init scaffolder", func() {
+	It("should scaffold the rbacgen binary", func() {
+		fs, _ := initTestProject()
+
+		expectGolden(fs, "init", filepath.Join("src", "rbac_generator.rs"))
+	})
+
+	It("should scaffold the kustomize config tree", func() {
+		fs, _ := initTestProject()
+
+		expectGolden(fs, "init",
+			"Makefile",
+			filepath.Join("config", "default", "kustomization.yaml"),
+			filepath.Join("config", "manager", "kustomization.yaml"),
+			filepath.Join("config", "manager", "manager.yaml"),
+			filepath.Join("config", "rbac", "kustomization.yaml"),
+			filepath.Join("config", "rbac", "service_account.yaml"),
+			filepath.Join("config", "rbac", "role.yaml"),
+			filepath.Join("config", "rbac", "role_binding.yaml"),
+			filepath.Join("config", "crd", "kustomization.yaml"),
+			filepath.Join("config", "samples", "kustomization.yaml"),
+			filepath.Join("config", "manifests", "kustomization.yaml"),
+			filepath.Join("config", "manifests", "bases", "test-operator.clusterserviceversion.yaml"),
+		)
+	})
+})
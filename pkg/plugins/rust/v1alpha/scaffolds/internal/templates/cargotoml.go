@@ -16,44 +16,183 @@ limitations under the License.
 
 package templates
 
-import "sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+import (
+	"fmt"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"strings"
+)
+
+const (
+	dependencyMarker  = "dependencies"
+	kubeFeatureMarker = "kube-features"
+
+	defaultCargoTomlPath = "Cargo.toml"
+)
 
 var _ machinery.Template = &CargoToml{}
 
 type CargoToml struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
+
+	// License is the boilerplate license of the project, rendered as its SPDX identifier
+	License string
+
+	// Owner is the author of the package, if any, rendered as a TOML string
+	Owner string
 }
 
 func (f *CargoToml) SetTemplateDefaults() error {
 	if f.Path == "" {
-		f.Path = "Cargo.toml"
+		f.Path = defaultCargoTomlPath
+	}
+
+	f.License = CargoLicense(f.License)
+	if f.Owner != "" {
+		f.Owner = TomlString(f.Owner)
 	}
 
-	f.TemplateBody = cargoTomlTemplate
+	f.TemplateBody = fmt.Sprintf(cargoTomlTemplate,
+		rust.NewMarkerFor(f.Path, kubeFeatureMarker),
+		rust.NewMarkerFor(f.Path, dependencyMarker),
+	)
 
 	return nil
 }
 
+// cargoLicenses maps the boilerplate licenses to their SPDX identifiers
+var cargoLicenses = map[string]string{
+	"apache2": "Apache-2.0",
+}
+
+// CargoLicense returns the SPDX identifier to use in Cargo.toml for the given boilerplate license
+func CargoLicense(license string) string {
+	if spdx, found := cargoLicenses[license]; found {
+		return spdx
+	}
+	return ""
+}
+
+// TomlString returns the value quoted as a TOML basic string
+func TomlString(value string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range value {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, "\\u%04X", r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+var _ machinery.Inserter = &CargoTomlUpdater{}
+
+// CargoTomlUpdater updates Cargo.toml to add the dependencies of optional components
+type CargoTomlUpdater struct { //nolint:maligned
+	// Flags to indicate which parts need to be included when updating the file
+	WireWebhook bool
+}
+
+// GetPath implements file.Builder
+func (*CargoTomlUpdater) GetPath() string {
+	return defaultCargoTomlPath
+}
+
+// GetIfExistsAction implements file.Builder
+func (*CargoTomlUpdater) GetIfExistsAction() machinery.IfExistsAction {
+	return machinery.OverwriteFile
+}
+
+// GetMarkers implements file.Inserter
+func (f *CargoTomlUpdater) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{
+		rust.NewMarkerFor(defaultCargoTomlPath, kubeFeatureMarker),
+		rust.NewMarkerFor(defaultCargoTomlPath, dependencyMarker),
+	}
+}
+
+const (
+	webhookDependenciesCodeFragment = `axum-server = { version = "0.7.2", features = ["tls-rustls"] }
+json-patch = "4.0.0"
+`
+	webhookKubeFeaturesCodeFragment = `    "admission",
+`
+)
+
+// GetCodeFragments implements file.Inserter
+func (f *CargoTomlUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
+	fragments := make(machinery.CodeFragmentsMap, 2)
+
+	// Generate kube feature code fragments
+	kubeFeatures := make([]string, 0)
+	if f.WireWebhook {
+		kubeFeatures = append(kubeFeatures, webhookKubeFeaturesCodeFragment)
+	}
+
+	// Generate dependency code fragments
+	dependencies := make([]string, 0)
+	if f.WireWebhook {
+		dependencies = append(dependencies, webhookDependenciesCodeFragment)
+	}
+
+	// Only store code fragments in the map if the slices are non-empty
+	if len(kubeFeatures) != 0 {
+		fragments[rust.NewMarkerFor(defaultCargoTomlPath, kubeFeatureMarker)] = kubeFeatures
+	}
+	if len(dependencies) != 0 {
+		fragments[rust.NewMarkerFor(defaultCargoTomlPath, dependencyMarker)] = dependencies
+	}
+
+	return fragments
+}
+
 const cargoTomlTemplate = `[package]
 name = "{{ .ProjectName }}"
 version = "0.1.0"
 edition = "2024"
 rust-version = "1.87.0"
+{{- if .License }}
+license = "{{ .License }}"
+{{- end }}
+{{- if .Owner }}
+authors = [{{ .Owner }}]
+{{- end }}
 
 [[bin]]
 name = "crdgen"
 path = "src/crd_generator.rs"
 
+[[bin]]
+name = "rbacgen"
+path = "src/rbac_generator.rs"
+
 [dependencies]
 futures = "0.3.31"
-k8s-openapi = { version = "0.25.0", features = ["latest"] }
-kube = { version = "1.0.0", features = ["runtime", "client", "derive"] }
+k8s-openapi = { version = "0.25.0", features = ["latest", "schemars"] }
+kube = { version = "1.0.0", features = [
+    "runtime",
+    "client",
+    "derive",
+    %s
+] }
 thiserror = "2.0.8"
-tokio = { version = "1.42.0", features = ["macros", "rt-multi-thread", "rt"] }
+tokio = { version = "1.42.0", features = ["macros", "rt-multi-thread", "rt", "time", "net"] }
 schemars = "0.8.21"
 serde = "1.0.216"
 serde_json = "1.0.134"
 serde_yaml = "0.9.34"
 async-trait = "0.1.83"
+axum = "0.8.4"
+prometheus = "0.14.0"
+tracing = "0.1.41"
+tracing-subscriber = { version = "0.3.19", features = ["env-filter", "json"] }
+%s
 `
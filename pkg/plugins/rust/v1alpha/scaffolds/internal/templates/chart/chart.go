@@ -0,0 +1,56 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chart
+
+import (
+	"path/filepath"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+// Dir is the directory of the Helm chart of the operator
+var Dir = filepath.Join("dist", "chart")
+
+var _ machinery.Template = &Chart{}
+
+// Chart scaffolds the Chart.yaml file describing the Helm chart of the operator
+type Chart struct {
+	machinery.TemplateMixin
+	machinery.ProjectNameMixin
+}
+
+// SetTemplateDefaults implements file.Template
+func (f *Chart) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join(Dir, "Chart.yaml")
+	}
+
+	f.TemplateBody = chartTemplate
+
+	f.IfExistsAction = machinery.Error
+
+	return nil
+}
+
+const chartTemplate = `apiVersion: v2
+name: {{ .ProjectName }}
+description: A Helm chart to deploy the {{ .ProjectName }} operator
+type: application
+# The version of the chart, to bump on each change of its templates.
+version: 0.1.0
+# The version of the operator deployed by the chart.
+appVersion: "0.1.0"
+`
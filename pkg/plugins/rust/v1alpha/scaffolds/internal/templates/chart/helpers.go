@@ -0,0 +1,80 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chart
+
+import (
+	"path/filepath"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &Helpers{}
+
+// Helpers scaffolds the _helpers.tpl file defining the names and labels shared by the templates
+// of the Helm chart
+type Helpers struct {
+	machinery.TemplateMixin
+}
+
+// SetTemplateDefaults implements file.Template
+func (f *Helpers) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join(Dir, "templates", "_helpers.tpl")
+	}
+
+	// The Helm directives of the chart are kept as they are
+	f.SetDelim("[[", "]]")
+	f.TemplateBody = helpersTemplate
+
+	f.IfExistsAction = machinery.Error
+
+	return nil
+}
+
+const helpersTemplate = `{{/*
+Name of the chart, prefixing the names of the objects it creates.
+*/}}
+{{- define "chart.name" -}}
+{{- .Chart.Name | trunc 63 | trimSuffix "-" }}
+{{- end }}
+
+{{/*
+Labels set on all the objects of the chart.
+*/}}
+{{- define "chart.labels" -}}
+helm.sh/chart: {{ .Chart.Name }}-{{ .Chart.Version | replace "+" "_" }}
+app.kubernetes.io/name: {{ include "chart.name" . }}
+app.kubernetes.io/instance: {{ .Release.Name }}
+app.kubernetes.io/version: {{ .Chart.AppVersion | quote }}
+app.kubernetes.io/managed-by: {{ .Release.Service }}
+{{- end }}
+
+{{/*
+Labels selecting the pods of the manager.
+*/}}
+{{- define "chart.selectorLabels" -}}
+control-plane: controller-manager
+app.kubernetes.io/name: {{ include "chart.name" . }}
+app.kubernetes.io/instance: {{ .Release.Name }}
+{{- end }}
+
+{{/*
+Name of the service account of the manager.
+*/}}
+{{- define "chart.serviceAccountName" -}}
+{{ include "chart.name" . }}-controller-manager
+{{- end }}
+`
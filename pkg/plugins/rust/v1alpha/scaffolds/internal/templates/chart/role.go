@@ -0,0 +1,267 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chart
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+const (
+	rulesMarker        = "rules"
+	clusterRulesMarker = "cluster-rules"
+)
+
+var rolePath = filepath.Join(Dir, "templates", "rbac", "role.yaml")
+
+var _ machinery.Template = &Role{}
+
+// Role scaffolds the template of the role of the manager and of its bindings, which is a ClusterRole
+// or a Role in each of the watched namespaces. The rules on cluster-scoped resources are granted by a
+// ClusterRole in either case.
+type Role struct {
+	machinery.TemplateMixin
+
+	// RulesMarker is where the rules of the controllers are added
+	RulesMarker machinery.Marker
+
+	// ClusterRulesMarker is where the rules of the controllers on cluster-scoped resources are added
+	ClusterRulesMarker machinery.Marker
+}
+
+// SetTemplateDefaults implements file.Template
+func (f *Role) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = rolePath
+	}
+
+	f.RulesMarker = rust.NewMarkerFor(f.Path, rulesMarker)
+	f.ClusterRulesMarker = rust.NewMarkerFor(f.Path, clusterRulesMarker)
+
+	f.SetDelim("[[", "]]")
+	f.TemplateBody = roleTemplate
+
+	f.IfExistsAction = machinery.Error
+
+	return nil
+}
+
+// Rule grants verbs on a resource of an API group, the core group being the empty one
+type Rule struct {
+	Group    string
+	Resource string
+	Verbs    []string
+
+	// Namespaced indicates whether the resource is namespace-scoped
+	Namespaced bool
+}
+
+// RuleGroup returns the API group of a rule on a kind of the given group. The core group is written
+// "core" in the RBAC markers and in the resources of the project, and empty in the manifests.
+func RuleGroup(group string) string {
+	if group == "core" {
+		return ""
+	}
+	return group
+}
+
+var _ machinery.Inserter = &RoleUpdater{}
+
+// RoleUpdater adds the rules needed by the controller of a resource to the role of the manager
+type RoleUpdater struct { //nolint:maligned
+	machinery.ResourceMixin
+
+	// Namespaced indicates whether the reconciled kind is namespace-scoped
+	Namespaced bool
+
+	// Rules are the rules on the secondary kinds owned or watched by the controller
+	Rules []Rule
+
+	// Flags to indicate which parts need to be included when updating the file
+	WireController bool
+}
+
+// GetPath implements file.Builder
+func (*RoleUpdater) GetPath() string {
+	return rolePath
+}
+
+// GetIfExistsAction implements file.Builder
+func (*RoleUpdater) GetIfExistsAction() machinery.IfExistsAction {
+	return machinery.OverwriteFile
+}
+
+// GetMarkers implements file.Inserter
+func (f *RoleUpdater) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{
+		rust.NewMarkerFor(rolePath, rulesMarker),
+		rust.NewMarkerFor(rolePath, clusterRulesMarker),
+	}
+}
+
+const (
+	ruleCodeFragment = `- apiGroups:
+  - %q
+  resources:
+  - %s
+  verbs:
+%s`
+)
+
+// GetCodeFragments implements file.Inserter
+func (f *RoleUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
+	fragments := make(machinery.CodeFragmentsMap, 2)
+
+	// If resource is not being provided we are creating the file, not updating it
+	if f.Resource == nil || !f.WireController {
+		return fragments
+	}
+
+	group := RuleGroup(f.Resource.QualifiedGroup())
+	rules := append([]Rule{
+		{Group: group, Resource: f.Resource.Plural, Namespaced: f.Namespaced,
+			Verbs: []string{"get", "list", "watch", "create", "update", "patch", "delete"}},
+		{Group: group, Resource: f.Resource.Plural + "/status", Namespaced: f.Namespaced,
+			Verbs: []string{"get", "update", "patch"}},
+		{Group: group, Resource: f.Resource.Plural + "/finalizers", Namespaced: f.Namespaced,
+			Verbs: []string{"update"}},
+	}, f.Rules...)
+
+	// The rules shared by several controllers are only added once
+	var code, clusterCode []string
+	for _, rule := range rules {
+		verbs := ""
+		for _, verb := range rule.Verbs {
+			verbs += fmt.Sprintf("  - %s\n", verb)
+		}
+		if rule.Namespaced {
+			code = append(code, fmt.Sprintf(ruleCodeFragment, rule.Group, rule.Resource, verbs))
+		} else {
+			clusterCode = append(clusterCode, fmt.Sprintf(ruleCodeFragment, rule.Group, rule.Resource, verbs))
+		}
+	}
+	if len(code) != 0 {
+		fragments[rust.NewMarkerFor(rolePath, rulesMarker)] = code
+	}
+	if len(clusterCode) != 0 {
+		fragments[rust.NewMarkerFor(rolePath, clusterRulesMarker)] = clusterCode
+	}
+
+	return fragments
+}
+
+const roleTemplate = `{{- define "chart.managerRules" }}
+- apiGroups:
+  - "events.k8s.io"
+  resources:
+  - events
+  verbs:
+  - create
+  - patch
+[[ .RulesMarker ]]
+{{- end }}
+{{- define "chart.managerClusterRules" }}
+[[ .ClusterRulesMarker ]]
+{{- end }}
+{{- if .Values.rbac.enable }}
+{{- if .Values.watchNamespaces }}
+{{- range .Values.watchNamespaces }}
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: {{ include "chart.name" $ }}-manager-role
+  namespace: {{ . }}
+  labels:
+    {{- include "chart.labels" $ | nindent 4 }}
+rules:
+{{- include "chart.managerRules" $ }}
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: {{ include "chart.name" $ }}-manager-rolebinding
+  namespace: {{ . }}
+  labels:
+    {{- include "chart.labels" $ | nindent 4 }}
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: {{ include "chart.name" $ }}-manager-role
+subjects:
+- kind: ServiceAccount
+  name: {{ include "chart.serviceAccountName" $ }}
+  namespace: {{ $.Release.Namespace }}
+{{- end }}
+{{- if contains "apiGroups" (include "chart.managerClusterRules" .) }}
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: {{ include "chart.name" . }}-manager-role
+  labels:
+    {{- include "chart.labels" . | nindent 4 }}
+rules:
+{{- include "chart.managerClusterRules" . }}
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: {{ include "chart.name" . }}-manager-rolebinding
+  labels:
+    {{- include "chart.labels" . | nindent 4 }}
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: {{ include "chart.name" . }}-manager-role
+subjects:
+- kind: ServiceAccount
+  name: {{ include "chart.serviceAccountName" . }}
+  namespace: {{ .Release.Namespace }}
+{{- end }}
+{{- else }}
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: {{ include "chart.name" . }}-manager-role
+  labels:
+    {{- include "chart.labels" . | nindent 4 }}
+rules:
+{{- include "chart.managerRules" . }}
+{{- include "chart.managerClusterRules" . }}
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: {{ include "chart.name" . }}-manager-rolebinding
+  labels:
+    {{- include "chart.labels" . | nindent 4 }}
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: {{ include "chart.name" . }}-manager-role
+subjects:
+- kind: ServiceAccount
+  name: {{ include "chart.serviceAccountName" . }}
+  namespace: {{ .Release.Namespace }}
+{{- end }}
+{{- end }}
+`
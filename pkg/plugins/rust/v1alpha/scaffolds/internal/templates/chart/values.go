@@ -0,0 +1,74 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chart
+
+import (
+	"fmt"
+	"path/filepath"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &Values{}
+
+// Values scaffolds the values.yaml file holding the settings of the Helm chart
+type Values struct {
+	machinery.TemplateMixin
+	machinery.ProjectNameMixin
+
+	Image string
+}
+
+// SetTemplateDefaults implements file.Template
+func (f *Values) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join(Dir, "values.yaml")
+	}
+
+	f.TemplateBody = valuesTemplate
+
+	f.IfExistsAction = machinery.Error
+
+	if f.Image == "" {
+		f.Image = fmt.Sprintf("%s:latest", f.ProjectName)
+	}
+
+	return nil
+}
+
+const valuesTemplate = `manager:
+  # Image of the operator, overridden by make helm-deploy with the value of IMG.
+  image: {{ .Image }}
+  imagePullPolicy: IfNotPresent
+  replicas: 1
+  # TODO(user): Configure the resources accordingly based on the project requirements.
+  # More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+  resources:
+    limits:
+      cpu: 500m
+      memory: 128Mi
+    requests:
+      cpu: 10m
+      memory: 64Mi
+
+# Namespaces watched by the controllers, all of them when empty. The manager is then granted
+# Roles in these namespaces instead of a ClusterRole.
+watchNamespaces: []
+
+rbac:
+  # Whether to create the service account of the manager and grant it its roles.
+  enable: true
+`
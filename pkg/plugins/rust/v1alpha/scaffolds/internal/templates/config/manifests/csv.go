@@ -0,0 +1,160 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"fmt"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	"path/filepath"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+const (
+	ownedMarker = "ownedcrds"
+)
+
+// CSVPath returns the path of the base ClusterServiceVersion of a project
+func CSVPath(projectName string) string {
+	return filepath.Join("config", "manifests", "bases", projectName+".clusterserviceversion.yaml")
+}
+
+var _ machinery.Template = &CSV{}
+
+// CSV scaffolds the base ClusterServiceVersion of the OLM bundle, listing the CRDs owned by the operator
+type CSV struct {
+	machinery.TemplateMixin
+	machinery.ProjectNameMixin
+
+	// OwnedMarker is where the CRDs of the APIs are added
+	OwnedMarker machinery.Marker
+}
+
+// SetTemplateDefaults implements file.Template
+func (f *CSV) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = CSVPath(f.ProjectName)
+	}
+
+	f.OwnedMarker = rust.NewMarkerFor(f.Path, ownedMarker)
+
+	f.TemplateBody = csvTemplate
+
+	return nil
+}
+
+var _ machinery.Inserter = &CSVUpdater{}
+
+// CSVUpdater adds the CRD of a resource to those owned by the ClusterServiceVersion
+type CSVUpdater struct { //nolint:maligned
+	machinery.ProjectNameMixin
+	machinery.ResourceMixin
+
+	// Flags to indicate which parts need to be included when updating the file
+	WireResource bool
+}
+
+// GetPath implements file.Builder
+func (f *CSVUpdater) GetPath() string {
+	return CSVPath(f.ProjectName)
+}
+
+// GetIfExistsAction implements file.Builder
+func (*CSVUpdater) GetIfExistsAction() machinery.IfExistsAction {
+	return machinery.OverwriteFile
+}
+
+// GetMarkers implements file.Inserter
+func (f *CSVUpdater) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{
+		rust.NewMarkerFor(f.GetPath(), ownedMarker),
+	}
+}
+
+const (
+	ownedCodeFragment = `    - description: %[1]s is the Schema for the %[2]s API
+      displayName: %[1]s
+      kind: %[1]s
+      name: %[2]s.%[3]s
+      version: %[4]s
+`
+)
+
+// GetCodeFragments implements file.Inserter
+func (f *CSVUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
+	fragments := make(machinery.CodeFragmentsMap, 1)
+
+	// If resource is not being provided we are creating the file, not updating it
+	if f.Resource == nil || !f.WireResource {
+		return fragments
+	}
+
+	// Each version of a kind is listed, as OLM tracks the owned CRDs by version
+	fragments[rust.NewMarkerFor(f.GetPath(), ownedMarker)] = []string{
+		fmt.Sprintf(ownedCodeFragment,
+			f.Resource.Kind, f.Resource.Plural, f.Resource.QualifiedGroup(), f.Resource.Version),
+	}
+
+	return fragments
+}
+
+const csvTemplate = `apiVersion: operators.coreos.com/v1alpha1
+kind: ClusterServiceVersion
+metadata:
+  annotations:
+    alm-examples: '[]'
+    capabilities: Basic Install
+  name: {{ .ProjectName }}.v0.0.0
+  namespace: placeholder
+spec:
+  apiservicedefinitions: {}
+  customresourcedefinitions:
+    owned:
+    {{ .OwnedMarker }}
+  description: TODO(user) describe {{ .ProjectName }}
+  displayName: {{ .ProjectName }}
+  icon:
+  - base64data: ""
+    mediatype: ""
+  install:
+    spec:
+      deployments: null
+    strategy: ""
+  # The controllers watch a set of namespaces through the WATCH_NAMESPACE environment variable, which
+  # is not set from the OperatorGroup of the operator.
+  installModes:
+  - supported: false
+    type: OwnNamespace
+  - supported: false
+    type: SingleNamespace
+  - supported: false
+    type: MultiNamespace
+  - supported: true
+    type: AllNamespaces
+  keywords:
+  - {{ .ProjectName }}
+  links:
+  - name: {{ .ProjectName }}
+    url: https://{{ .ProjectName }}.domain
+  maintainers:
+  - email: your@email.com
+    name: Maintainer Name
+  maturity: alpha
+  provider:
+    name: Provider Name
+    url: https://your.domain
+  version: 0.0.0
+`
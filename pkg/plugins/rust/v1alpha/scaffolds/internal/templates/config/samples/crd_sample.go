@@ -0,0 +1,77 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samples
+
+import (
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	"path/filepath"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"strings"
+)
+
+var _ machinery.Template = &CRDSample{}
+
+// CRDSample scaffolds a file that defines a sample manifest for the CRD
+type CRDSample struct {
+	machinery.TemplateMixin
+	machinery.ResourceMixin
+	machinery.ProjectNameMixin
+
+	Force bool
+
+	// SpecFields are the fields of the spec, set to example values
+	SpecFields []rust.Field
+}
+
+// SetTemplateDefaults implements file.Template
+func (f *CRDSample) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "samples",
+			sampleFileName(f.Resource.Group, f.Resource.Version, f.Resource.Kind))
+	}
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
+	f.TemplateBody = crdSampleTemplate
+
+	return nil
+}
+
+// Name returns the name of the sample custom resource
+func (f *CRDSample) Name() string {
+	return strings.ToLower(f.Resource.Kind) + "-sample"
+}
+
+const crdSampleTemplate = `apiVersion: {{ .Resource.QualifiedGroup }}/{{ .Resource.Version }}
+kind: {{ .Resource.Kind }}
+metadata:
+  labels:
+    app.kubernetes.io/name: {{ .ProjectName }}
+    app.kubernetes.io/managed-by: kustomize
+  name: {{ .Name }}
+spec:
+{{- range .SpecFields }}
+  {{ .JSONName }}: {{ .Sample }}
+{{- else }}
+  # TODO(user): Add fields here
+  foo: bar
+{{- end }}
+`
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samples
+
+import (
+	"fmt"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	"path/filepath"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"strings"
+)
+
+const (
+	sampleMarker = "manifestskustomizesamples"
+)
+
+var defaultKustomizationPath = filepath.Join("config", "samples", "kustomization.yaml")
+
+var _ machinery.Template = &Kustomization{}
+
+// Kustomization scaffolds a file that defines the kustomization scheme for the samples folder
+type Kustomization struct {
+	machinery.TemplateMixin
+}
+
+// SetTemplateDefaults implements file.Template
+func (f *Kustomization) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = defaultKustomizationPath
+	}
+
+	f.TemplateBody = fmt.Sprintf(kustomizationTemplate,
+		rust.NewMarkerFor(f.Path, sampleMarker),
+	)
+
+	return nil
+}
+
+var _ machinery.Inserter = &KustomizationUpdater{}
+
+// KustomizationUpdater adds the sample of a resource to the samples kustomization
+type KustomizationUpdater struct { //nolint:maligned
+	machinery.ResourceMixin
+}
+
+// GetPath implements file.Builder
+func (*KustomizationUpdater) GetPath() string {
+	return defaultKustomizationPath
+}
+
+// GetIfExistsAction implements file.Builder
+func (*KustomizationUpdater) GetIfExistsAction() machinery.IfExistsAction {
+	return machinery.OverwriteFile
+}
+
+// GetMarkers implements file.Inserter
+func (f *KustomizationUpdater) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{
+		rust.NewMarkerFor(defaultKustomizationPath, sampleMarker),
+	}
+}
+
+const (
+	sampleCodeFragment = `- %s
+`
+)
+
+// GetCodeFragments implements file.Inserter
+func (f *KustomizationUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
+	fragments := make(machinery.CodeFragmentsMap, 1)
+
+	// If resource is not being provided we are creating the file, not updating it
+	if f.Resource == nil {
+		return fragments
+	}
+
+	fragments[rust.NewMarkerFor(defaultKustomizationPath, sampleMarker)] = []string{
+		fmt.Sprintf(sampleCodeFragment, sampleFileName(f.Resource.Group, f.Resource.Version, f.Resource.Kind)),
+	}
+
+	return fragments
+}
+
+// sampleFileName returns the name of the sample file of a resource within config/samples
+func sampleFileName(group, version, kind string) string {
+	return fmt.Sprintf("%s_%s_%s.yaml", group, version, strings.ToLower(kind))
+}
+
+const kustomizationTemplate = `## Append samples of your project ##
+resources:
+%s
+`
@@ -53,6 +53,9 @@ Cargo.lock
 # MSVC Windows builds of rustc generate these, which store debugging information
 *.pdb
 
+# Binaries for the tools downloaded by the Makefile
+bin/
+
 # IDE
 .idea/
 .vscode/
@@ -18,9 +18,17 @@ package templates
 
 import (
 	"fmt"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 )
 
+const (
+	manifestsMarker = "manifests"
+	chartCRDsMarker = "chartcrds"
+
+	defaultMakefilePath = "Makefile"
+)
+
 var _ machinery.Template = &Makefile{}
 
 type Makefile struct {
@@ -28,13 +36,25 @@ type Makefile struct {
 	machinery.ProjectNameMixin
 
 	Image string
+
+	// Helm indicates whether the project has a Helm chart, whose CRDs are copied by generate-crds
+	Helm bool
+
+	// ManifestsMarker is where the steps of the resources are added to the manifests target
+	ManifestsMarker machinery.Marker
+
+	// ChartCRDsMarker is where the copies of the CRDs into the Helm chart are added
+	ChartCRDsMarker machinery.Marker
 }
 
 func (f *Makefile) SetTemplateDefaults() error {
 	if f.Path == "" {
-		f.Path = "Makefile"
+		f.Path = defaultMakefilePath
 	}
 
+	f.ManifestsMarker = rust.NewMarkerFor(f.Path, manifestsMarker)
+	f.ChartCRDsMarker = rust.NewMarkerFor(f.Path, chartCRDsMarker)
+
 	f.TemplateBody = makefileTemplate
 
 	f.IfExistsAction = machinery.Error
@@ -46,6 +66,70 @@ func (f *Makefile) SetTemplateDefaults() error {
 	return nil
 }
 
+var _ machinery.Inserter = &MakefileUpdater{}
+
+// MakefileUpdater adds the steps of a resource to the targets of the Makefile
+type MakefileUpdater struct { //nolint:maligned
+	machinery.ResourceMixin
+
+	// Flags to indicate which parts need to be included when updating the file
+	WireResource bool
+
+	// Helm indicates whether the CRD of the resource is copied into the Helm chart
+	Helm bool
+}
+
+// GetPath implements file.Builder
+func (*MakefileUpdater) GetPath() string {
+	return defaultMakefilePath
+}
+
+// GetIfExistsAction implements file.Builder
+func (*MakefileUpdater) GetIfExistsAction() machinery.IfExistsAction {
+	return machinery.OverwriteFile
+}
+
+// GetMarkers implements file.Inserter
+func (f *MakefileUpdater) GetMarkers() []machinery.Marker {
+	markers := []machinery.Marker{
+		rust.NewMarkerFor(defaultMakefilePath, manifestsMarker),
+	}
+	if f.Helm {
+		markers = append(markers, rust.NewMarkerFor(defaultMakefilePath, chartCRDsMarker))
+	}
+	return markers
+}
+
+const (
+	crdCheckCodeFragment = `	@test -f config/crd/bases/%[1]s_%[2]s.yaml || \
+		(echo "The CRD of %[3]s was not generated, check src/crd_generator.rs" && exit 1)
+`
+	chartCRDCodeFragment = `	cp config/crd/bases/%[1]s_%[2]s.yaml dist/chart/crds/
+`
+)
+
+// GetCodeFragments implements file.Inserter
+func (f *MakefileUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
+	fragments := make(machinery.CodeFragmentsMap, 1)
+
+	// If resource is not being provided we are creating the file, not updating it
+	if f.Resource == nil || !f.WireResource {
+		return fragments
+	}
+
+	// The versions of a kind share the same CRD, whose check is only added once
+	fragments[rust.NewMarkerFor(defaultMakefilePath, manifestsMarker)] = []string{
+		fmt.Sprintf(crdCheckCodeFragment, f.Resource.QualifiedGroup(), f.Resource.Plural, f.Resource.Kind),
+	}
+	if f.Helm {
+		fragments[rust.NewMarkerFor(defaultMakefilePath, chartCRDsMarker)] = []string{
+			fmt.Sprintf(chartCRDCodeFragment, f.Resource.QualifiedGroup(), f.Resource.Plural),
+		}
+	}
+
+	return fragments
+}
+
 const makefileTemplate = `# Image URL to use for all building/pushing image targets
 IMG ?= {{ .Image }}
 
@@ -77,8 +161,38 @@ help: ## Display this help.
 	@awk 'BEGIN {FS = ":.*##"; printf "\nUsage:\n  make \033[36m<target>\033[0m\n"} /^[a-zA-Z_0-9-]+:.*?##/ { printf "  \033[36m%-15s\033[0m %s\n", $$1, $$2 } /^##@/ { printf "\n\033[1m%s\033[0m\n", substr($$0, 5) } ' $(MAKEFILE_LIST)
 
 .PHONY: generate-crds
-generate-crds:
-	 cargo run --bin crdgen
+generate-crds: ## Generate the CustomResourceDefinitions under config/crd/bases from the Rust types.
+	cargo run --bin crdgen
+{{- if .Helm }}
+	@mkdir -p dist/chart/crds
+{{ .ChartCRDsMarker }}
+{{- end }}
+
+.PHONY: generate-rbac
+generate-rbac: ## Generate config/rbac/role.yaml from the +kubebuilder:rbac markers in the Rust sources.
+	cargo run --bin rbacgen
+
+.PHONY: manifests
+manifests: generate-crds generate-rbac ## Generate the CRDs and the RBAC manifests, checking the CRD of each API.
+{{ .ManifestsMarker }}
+
+##@ Development
+
+.PHONY: fmt
+fmt: ## Format the Rust sources with cargo fmt.
+	cargo fmt
+
+.PHONY: fmt-check
+fmt-check: ## Check the formatting of the Rust sources with cargo fmt, without changing them.
+	cargo fmt --check
+
+.PHONY: lint
+lint: ## Lint the Rust sources with cargo clippy, failing on warnings.
+	cargo clippy --all-targets -- -D warnings
+
+.PHONY: test
+test: fmt-check ## Run the tests of the operator.
+	cargo test
 
 ##@ Build
 
@@ -105,18 +219,84 @@ ifndef ignore-not-found
 endif
 
 .PHONY: install
-install: ## Install CRDs into the K8s cluster specified in ~/.kube/config.
-	@$(foreach file, $(wildcard target/kubernetes/*-v1alpha1.yaml), kubectl apply -f $(file);)
+install: generate-crds kustomize ## Install CRDs into the K8s cluster specified in ~/.kube/config.
+	$(KUSTOMIZE) build config/crd | kubectl apply -f -
 
 .PHONY: uninstall
-uninstall: ## Uninstall CRDs from the K8s cluster specified in ~/.kube/config.
-	@$(foreach file, $(wildcard target/kubernete s/*-v1alpha1.yaml), kubectl delete -f $(file) --ignore-not-found=$(ignore-not-found);)
+uninstall: kustomize ## Uninstall CRDs from the K8s cluster specified in ~/.kube/config. Call with ignore-not-found=true to ignore resource not found errors during deletion.
+	$(KUSTOMIZE) build config/crd | kubectl delete --ignore-not-found=$(ignore-not-found) -f -
 
 .PHONY: deploy
-deploy: ## Deploy controller to the K8s cluster specified in ~/.kube/config.
-	kubectl create deployment {{ .ProjectName }} --image ${IMG}
+deploy: manifests kustomize ## Deploy controller to the K8s cluster specified in ~/.kube/config.
+	cd config/manager && $(KUSTOMIZE) edit set image controller=${IMG}
+	$(KUSTOMIZE) build config/default | kubectl apply -f -
 
 .PHONY: undeploy
-undeploy: ## Undeploy controller from the K8s cluster specified in ~/.kube/config.
-	kubectl delete deployment {{ .ProjectName }} --ignore-not-found=$(ignore-not-found)
+undeploy: kustomize ## Undeploy controller from the K8s cluster specified in ~/.kube/config. Call with ignore-not-found=true to ignore resource not found errors during deletion.
+	$(KUSTOMIZE) build config/default | kubectl delete --ignore-not-found=$(ignore-not-found) -f -
+{{- if .Helm }}
+
+.PHONY: helm-deploy
+helm-deploy: manifests ## Deploy controller with the Helm chart under dist/chart to the K8s cluster specified in ~/.kube/config.
+	helm upgrade --install {{ .ProjectName }} dist/chart --namespace {{ .ProjectName }}-system --create-namespace \
+		--set manager.image=${IMG}
+
+.PHONY: helm-undeploy
+helm-undeploy: ## Undeploy controller installed with the Helm chart from the K8s cluster specified in ~/.kube/config.
+	helm uninstall {{ .ProjectName }} --namespace {{ .ProjectName }}-system
+{{- end }}
+
+##@ Bundle
+
+# VERSION defines the version of the operator in the bundle, to bump before building a new bundle.
+VERSION ?= 0.0.1
+
+# CHANNELS and DEFAULT_CHANNEL define the channels of the bundle, e.g. CHANNELS=candidate,fast,stable
+# and DEFAULT_CHANNEL=stable.
+ifneq ($(origin CHANNELS), undefined)
+BUNDLE_CHANNELS := --channels=$(CHANNELS)
+endif
+ifneq ($(origin DEFAULT_CHANNEL), undefined)
+BUNDLE_DEFAULT_CHANNEL := --default-channel=$(DEFAULT_CHANNEL)
+endif
+BUNDLE_METADATA_OPTS ?= $(BUNDLE_CHANNELS) $(BUNDLE_DEFAULT_CHANNEL)
+BUNDLE_GEN_FLAGS ?= -q --overwrite --version $(VERSION) $(BUNDLE_METADATA_OPTS)
+
+# BUNDLE_IMG defines the image:tag used for the bundle.
+BUNDLE_IMG ?= {{ .ProjectName }}-bundle:v$(VERSION)
+
+# OPERATOR_SDK is the operator-sdk binary built with the Rust plugin.
+OPERATOR_SDK ?= operator-sdk
+
+.PHONY: bundle
+bundle: manifests kustomize ## Generate the bundle manifests and metadata from config/manifests, then validate them.
+	cd config/manager && $(KUSTOMIZE) edit set image controller=${IMG}
+	$(KUSTOMIZE) build config/manifests | $(OPERATOR_SDK) generate bundle $(BUNDLE_GEN_FLAGS)
+	$(OPERATOR_SDK) bundle validate ./bundle
+
+.PHONY: bundle-build
+bundle-build: ## Build the bundle image.
+	$(CONTAINER_TOOL) build -f bundle.Dockerfile -t $(BUNDLE_IMG) .
+
+.PHONY: bundle-push
+bundle-push: ## Push the bundle image.
+	$(CONTAINER_TOOL) push $(BUNDLE_IMG)
+
+##@ Dependencies
+
+## Location to install dependencies to
+LOCALBIN ?= $(shell pwd)/bin
+$(LOCALBIN):
+	mkdir -p $(LOCALBIN)
+
+## Tool Binaries
+KUSTOMIZE ?= $(LOCALBIN)/kustomize
+
+## Tool Versions
+KUSTOMIZE_VERSION ?= v5.4.3
+
+.PHONY: kustomize
+kustomize: $(KUSTOMIZE) ## Download kustomize locally if necessary.
+$(KUSTOMIZE): $(LOCALBIN)
+	curl -sSL "https://raw.githubusercontent.com/kubernetes-sigs/kustomize/master/hack/install_kustomize.sh" | bash -s -- $(subst v,,$(KUSTOMIZE_VERSION)) $(LOCALBIN)
 `
@@ -48,10 +48,11 @@ func (f *Readme) SetTemplateDefaults() error {
 		codeFence("make run"),
 		codeFence("make image-build image-push IMG=<some-registry>/{{ .ProjectName }}:tag"),
 		codeFence("make generate-crds"),
+		codeFence("make generate-rbac"),
 		codeFence("make install"),
 		codeFence("make deploy IMG=<some-registry>/{{ .ProjectName }}:tag"),
-		codeFence("kubectl apply -k path/to/your/samples/"),
-		codeFence("kubectl delete -k path/to/your/samples/"),
+		codeFence("kubectl apply -k config/samples/"),
+		codeFence("kubectl delete -k config/samples/"),
 		codeFence("make uninstall"),
 		codeFence("make undeploy"),
 	)
@@ -101,6 +102,15 @@ const readmeFileTemplate = `# {{ .ProjectName }}
 
 %s
 
+**Generate the RBAC rules from the ` + "`+kubebuilder:rbac`" + ` markers of your controllers:**
+
+%s
+
+> **NOTE**: The controllers watch every namespace unless the ` + "`WATCH_NAMESPACE`" + ` environment variable of
+> ` + "`config/manager/manager.yaml`" + ` lists some, e.g. ` + "`ns1,ns2`" + `. In that case, generate Roles in these
+> namespaces instead of a ClusterRole with ` + "`make generate-rbac WATCH_NAMESPACE=ns1,ns2`" + `.
+> The rules on cluster-scoped kinds, marked with ` + "`scope=Cluster`" + `, are kept in a ClusterRole.
+
 **Install the CRDs into the cluster:**
 
 %s
@@ -109,8 +119,10 @@ const readmeFileTemplate = `# {{ .ProjectName }}
 
 %s
 
-> **IMPORTANT**: You will face API access errors, as this script only creates the deployment.
-> You will need to create the required role bindings for your deployment for now.
+> **NOTE**: The manifests are built with kustomize from the ` + "`config/default`" + ` directory,
+> including the RBAC rules scaffolded under ` + "`config/rbac`" + `. The deployment passes ` + "`--leader-elect`" + `,
+> so that only the replica holding the Lease of ` + "`src/leader_election.rs`" + ` runs the controllers.
+> The reconcile metrics are served on port 8080 at ` + "`/metrics`" + `, next to the ` + "`/healthz`" + ` and ` + "`/readyz`" + ` probes.
 
 **Create instances of your solution**
 You can apply your example CRs:
@@ -137,6 +149,9 @@ You can apply your example CRs:
 
 // TODO(user): Add detailed information on how you would like others to contribute to this project
 
+Format, lint and test your changes with ` + "`make fmt lint test`" + `. The CRDs and RBAC rules are regenerated by
+` + "`make install`" + ` and ` + "`make deploy`" + `, or by ` + "`make manifests`" + ` which also checks that each API has a CRD.
+
 **NOTE:** Run ` + "`make help`" + ` for more information on all potential ` + "`make`" + ` targets
 
 More information can be found via the [Kubebuilder Documentation](https://book.kubebuilder.io/introduction.html)
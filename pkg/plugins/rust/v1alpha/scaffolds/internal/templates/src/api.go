@@ -22,7 +22,6 @@ import (
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/constants"
 	"path/filepath"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
-	"strings"
 )
 
 const (
@@ -53,6 +52,7 @@ var _ machinery.Inserter = &ApiUpdater{}
 
 type ApiUpdater struct { //nolint:maligned
 	machinery.ResourceMixin
+	machinery.MultiGroupMixin
 
 	// Flags to indicate which parts need to be included when updating the file
 	WireResource, WireController bool
@@ -76,7 +76,7 @@ func (f *ApiUpdater) GetMarkers() []machinery.Marker {
 }
 
 const (
-	moduleImportCodeFragment = `pub mod %s_types;
+	apiModuleCodeFragment = `pub mod %s;
 `
 )
 
@@ -92,7 +92,12 @@ func (f *ApiUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
 	// Generate module code fragments
 	modules := make([]string, 0)
 	if f.WireResource {
-		modules = append(modules, fmt.Sprintf(moduleImportCodeFragment, strings.ToLower(f.Resource.Kind)))
+		// The versions are nested in the module of their group in the multigroup layout
+		if f.MultiGroup {
+			modules = append(modules, fmt.Sprintf(apiModuleCodeFragment, rust.GroupModule(f.Resource.Group)))
+		} else {
+			modules = append(modules, fmt.Sprintf(apiModuleCodeFragment, f.Resource.Version))
+		}
 	}
 
 	// Only store code fragments in the map if the slices are non-empty
@@ -104,7 +109,7 @@ func (f *ApiUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
 }
 
 // nolint:lll
-var apiTemplate = `{{ .Boilerplate }}
+var apiTemplate = `{{ if .Boilerplate }}{{ .Boilerplate }}
 
-%s
+{{ end }}%s
 `
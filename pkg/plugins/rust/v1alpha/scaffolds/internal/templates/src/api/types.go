@@ -17,9 +17,12 @@ limitations under the License.
 package api
 
 import (
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
 	"log"
 	"path/filepath"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"slices"
 )
 
 var _ machinery.Template = &Types{}
@@ -30,18 +33,41 @@ type Types struct {
 	machinery.TemplateMixin
 	machinery.ResourceMixin
 	machinery.BoilerplateMixin
+	machinery.MultiGroupMixin
 
 	Force bool
+
+	// ShortNames and Categories are the short names and categories of the resource used by kubectl
+	ShortNames []string
+	Categories []string
+
+	// PrintColumns are the additional columns shown by kubectl get
+	PrintColumns []rust.PrintColumn
+
+	// IrregularPlural indicates whether the plural of the resource must be set explicitly
+	IrregularPlural bool
+
+	// SpecFields and StatusFields are the fields of the spec and the status, the spec getting an example
+	// field when none is given
+	SpecFields   []rust.Field
+	StatusFields []rust.Field
+
+	// UsesMap indicates whether a field is a map, whose type must be imported
+	UsesMap bool
 }
 
 func (f *Types) SetTemplateDefaults() error {
 	if f.Path == "" {
-		f.Path = filepath.Join("src", "api", "%[kind]_types.rs")
+		f.Path = filepath.Join(rust.APIDir(f.Resource.GVK, f.MultiGroup), "%[kind]_types.rs")
 	}
 
 	f.Path = f.Resource.Replacer().Replace(f.Path)
 	log.Println(f.Path)
 
+	f.IrregularPlural = f.Resource.Plural != resource.RegularPlural(f.Resource.Kind)
+	f.UsesMap = slices.ContainsFunc(append(append([]rust.Field{}, f.SpecFields...), f.StatusFields...),
+		rust.Field.IsMap)
+
 	f.TemplateBody = typesTemplate
 
 	if f.Force {
@@ -53,29 +79,74 @@ func (f *Types) SetTemplateDefaults() error {
 	return nil
 }
 
-const typesTemplate = `{{ .Boilerplate }}
+const typesTemplate = `{{ if .Boilerplate }}{{ .Boilerplate }}
 
+{{ end }}use k8s_openapi::apimachinery::pkg::apis::meta::v1::Condition;
 use k8s_openapi::serde::{Deserialize, Serialize};
 use kube::CustomResource;
 use schemars::JsonSchema;
+{{- if .UsesMap }}
+use std::collections::BTreeMap;
+{{- end }}
 
 #[derive(CustomResource, Deserialize, Serialize, Clone, Debug, JsonSchema)]
 #[kube(
     kind = "{{ .Resource.Kind }}",
-    group = "{{ .Resource.Group }}",
+    group = "{{ .Resource.QualifiedGroup }}",
     version = "{{ .Resource.Version }}",
+{{- if .Resource.API.Namespaced }}
     namespaced,
-	status = "{{ .Resource.Kind }}Status"
+{{- end }}
+{{- if .IrregularPlural }}
+    plural = "{{ .Resource.Plural }}",
+{{- end }}
+{{- range .ShortNames }}
+    shortname = "{{ . }}",
+{{- end }}
+{{- range .Categories }}
+    category = "{{ . }}",
+{{- end }}
+{{- range .PrintColumns }}
+    printcolumn = {{ .Literal }},
+{{- end }}
+    status = "{{ .Resource.Kind }}Status"
 )]
+#[serde(rename_all = "camelCase")]
 pub struct {{ .Resource.Kind }}Spec {
-	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+    // INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+{{- range $i, $_ := .SpecFields }}{{ if $i }}
+{{ end }}
+` + fieldTemplate + `
+{{- else }}
 
-	// foo is an example field of {{ .Resource.Kind }}. Edit {{ lower .Resource.Kind }}_types.rs to remove/update
+    // foo is an example field of {{ .Resource.Kind }}. Edit {{ lower .Resource.Kind }}_types.rs to remove/update
     foo: String,
+{{- end }}
 }
 
-#[derive(Deserialize, Serialize, Clone, Debug, JsonSchema)]
+#[derive(Deserialize, Serialize, Clone, Debug, Default, JsonSchema)]
+#[serde(rename_all = "camelCase")]
 pub struct {{ .Resource.Kind }}Status {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+    // INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+{{- range $i, $_ := .StatusFields }}{{ if $i }}
+{{ end }}
+` + fieldTemplate + `
+{{- end }}
+{{- if .StatusFields }}
+{{ end }}
+    /// Latest observations of the state of the {{ .Resource.Kind }}.
+    #[serde(default, skip_serializing_if = "Vec::is_empty")]
+    pub conditions: Vec<Condition>,
 }
 `
+
+// fieldTemplate renders a field of the spec or the status, documented for the OpenAPI schema of the CRD
+const fieldTemplate = `    /// {{ .Description $.Resource.Kind }}
+{{- if .SchemaHint }}
+    #[schemars({{ .SchemaHint }})]
+{{- end }}
+{{- if not .Required }}
+    #[serde(skip_serializing_if = "Option::is_none")]
+{{- end }}
+    pub {{ .RustName }}: {{ .RustType }},
+`
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package src
+
+import (
+	"path/filepath"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+const (
+	defaultConditionsPath = "src/conditions.rs"
+)
+
+var _ machinery.Template = &Conditions{}
+
+// Conditions scaffolds a file that helps the controllers maintain the standard conditions in the status
+// of their resources
+type Conditions struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+}
+
+// SetTemplateDefaults implements file.Template
+func (f *Conditions) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join(defaultConditionsPath)
+	}
+
+	f.TemplateBody = conditionsTemplate
+
+	return nil
+}
+
+var conditionsTemplate = `{{ if .Boilerplate }}{{ .Boilerplate }}
+
+{{ end }}//! Helpers maintaining the standard conditions in the status of the resources,
+//! as described by the Kubernetes API conventions.
+
+// The helpers are only used by the controllers that need them.
+#![allow(dead_code)]
+
+use k8s_openapi::apimachinery::pkg::apis::meta::v1::{Condition, Time};
+use k8s_openapi::jiff::Timestamp;
+use kube::Resource;
+
+/// The resource is fully reconciled and available.
+pub const AVAILABLE: &str = "Available";
+/// The resource is being created or updated.
+pub const PROGRESSING: &str = "Progressing";
+/// The resource failed to reach or maintain its desired state.
+pub const DEGRADED: &str = "Degraded";
+
+/// Returns a condition of the given type and status observed at the current
+/// generation of the object, the reason being a CamelCase word.
+pub fn new<K: Resource>(
+    obj: &K,
+    type_: &str,
+    status: bool,
+    reason: &str,
+    message: &str,
+) -> Condition {
+    Condition {
+        type_: type_.to_string(),
+        status: if status { "True" } else { "False" }.to_string(),
+        reason: reason.to_string(),
+        message: message.to_string(),
+        observed_generation: obj.meta().generation,
+        last_transition_time: Time(Timestamp::now()),
+    }
+}
+
+/// Returns the condition of the given type, if any.
+pub fn find<'a>(conditions: &'a [Condition], type_: &str) -> Option<&'a Condition> {
+    conditions.iter().find(|condition| condition.type_ == type_)
+}
+
+/// Returns whether the condition of the given type is set with the True status.
+pub fn is_true(conditions: &[Condition], type_: &str) -> bool {
+    find(conditions, type_).is_some_and(|condition| condition.status == "True")
+}
+
+/// Sets a condition, replacing the one of the same type. The last transition
+/// time of the replaced condition is kept unless the status changes. Returns
+/// whether the conditions changed.
+pub fn set(conditions: &mut Vec<Condition>, mut condition: Condition) -> bool {
+    match conditions
+        .iter_mut()
+        .find(|current| current.type_ == condition.type_)
+    {
+        Some(current) => {
+            if current.status == condition.status {
+                condition.last_transition_time = current.last_transition_time.clone();
+            }
+            let changed = *current != condition;
+            *current = condition;
+            changed
+        }
+        None => {
+            conditions.push(condition);
+            true
+        }
+    }
+}
+
+/// Removes the condition of the given type. Returns whether it was set.
+pub fn remove(conditions: &mut Vec<Condition>, type_: &str) -> bool {
+    let len = conditions.len();
+    conditions.retain(|condition| condition.type_ != type_);
+    conditions.len() != len
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    fn condition(type_: &str, status: &str, seconds: i64) -> Condition {
+        Condition {
+            type_: type_.to_string(),
+            status: status.to_string(),
+            reason: "Reconciled".to_string(),
+            message: String::new(),
+            observed_generation: Some(1),
+            last_transition_time: Time(Timestamp::from_second(seconds).unwrap()),
+        }
+    }
+
+    #[test]
+    fn set_keeps_the_transition_time_of_an_unchanged_status() {
+        let mut conditions = vec![condition(AVAILABLE, "True", 1)];
+        assert!(!set(&mut conditions, condition(AVAILABLE, "True", 2)));
+        assert_eq!(conditions, vec![condition(AVAILABLE, "True", 1)]);
+
+        assert!(set(&mut conditions, condition(AVAILABLE, "False", 3)));
+        assert_eq!(conditions, vec![condition(AVAILABLE, "False", 3)]);
+    }
+
+    #[test]
+    fn set_find_and_remove() {
+        let mut conditions = Vec::new();
+        assert!(set(&mut conditions, condition(PROGRESSING, "True", 1)));
+        assert!(is_true(&conditions, PROGRESSING));
+        assert!(find(&conditions, DEGRADED).is_none());
+
+        assert!(remove(&mut conditions, PROGRESSING));
+        assert!(!remove(&mut conditions, PROGRESSING));
+        assert!(conditions.is_empty());
+    }
+}
+`
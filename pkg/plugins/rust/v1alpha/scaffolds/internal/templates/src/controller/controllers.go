@@ -17,8 +17,12 @@ limitations under the License.
 package controller
 
 import (
+	"fmt"
 	"path/filepath"
+	"sort"
+	"strings"
 
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
 	log "github.com/sirupsen/logrus"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
@@ -32,19 +36,78 @@ type Controllers struct {
 	machinery.TemplateMixin
 	machinery.ResourceMixin
 	machinery.BoilerplateMixin
+	machinery.MultiGroupMixin
 
 	Force bool
+
+	// Namespaced indicates whether the reconciled kind is namespace-scoped
+	Namespaced bool
+
+	// Finalizer indicates whether the reconciler registers a finalizer, splitting the reconciliation
+	// into the apply and cleanup of the resource
+	Finalizer bool
+
+	// Status indicates whether the reconciled kind is an API of the project, whose conditions the
+	// reconciler reports in its status
+	Status bool
+
+	// Owns are the secondary kinds created by the reconciler, whose changes trigger the reconciliation
+	// of their owner
+	Owns []Relationship
+
+	// Watches are the secondary kinds whose changes are mapped to the resources to reconcile
+	Watches []Relationship
+
+	// CrateImports and ExternalImports are the paths used from the crate and from other crates,
+	// sorted as rustfmt does
+	CrateImports    []string
+	ExternalImports []string
+
+	// UsesScopedAPI and UsesClusterAPI indicate whether the secondary kinds include namespaced
+	// and cluster-scoped ones
+	UsesScopedAPI  bool
+	UsesClusterAPI bool
+
+	// ChainBreak and ChainIndent lay out the calls registering the secondary kinds as rustfmt does,
+	// which keeps a single call on the line of the controller
+	ChainBreak  string
+	ChainIndent string
+}
+
+// Relationship is a secondary kind owned or watched by a controller
+type Relationship struct {
+	// Kind is the name of the secondary kind
+	Kind string
+
+	// Type is the path of the Rust type of the kind
+	Type string
+
+	// Group is the API group of the kind as written in RBAC markers
+	Group string
+
+	// Plural is the name of the resource of the kind
+	Plural string
+
+	// Namespaced indicates whether the kind is namespace-scoped
+	Namespaced bool
 }
 
 // SetTemplateDefaults implements file.Template
 func (f *Controllers) SetTemplateDefaults() error {
 	if f.Path == "" {
-		f.Path = filepath.Join("src", "controller", "%[kind]_controller.rs")
+		f.Path = filepath.Join(rust.ControllerDir(f.Resource.GVK, f.MultiGroup), "%[kind]_controller.rs")
 	}
 
 	f.Path = f.Resource.Replacer().Replace(f.Path)
 	log.Println(f.Path)
 
+	f.setImports()
+
+	f.ChainBreak, f.ChainIndent = "\n            ", "            "
+	if len(f.Owns)+len(f.Watches) == 1 {
+		f.ChainBreak, f.ChainIndent = "", "        "
+	}
+
 	f.TemplateBody = controllerTemplate
 
 	if f.Force {
@@ -56,31 +119,231 @@ func (f *Controllers) SetTemplateDefaults() error {
 	return nil
 }
 
+// setImports collects the imports of the reconciled and secondary kinds
+func (f *Controllers) setImports() {
+	crateImports := map[string]bool{}
+	externalImports := map[string]bool{}
+
+	// The types of the kinds without an API in the project are imported from the path of the resource
+	if f.Resource.Path == "" {
+		crateImports[fmt.Sprintf("%s::%s", rust.APIModule(f.Resource.GVK, f.MultiGroup), f.Resource.Kind)] = true
+	} else if strings.HasPrefix(f.Resource.Path, "crate::") {
+		crateImports[fmt.Sprintf("%s::%s", f.Resource.Path, f.Resource.Kind)] = true
+	} else {
+		externalImports[fmt.Sprintf("%s::%s", f.Resource.Path, f.Resource.Kind)] = true
+	}
+	for _, relationship := range append(append([]Relationship{}, f.Owns...), f.Watches...) {
+		if strings.HasPrefix(relationship.Type, "crate::") {
+			crateImports[relationship.Type] = true
+		} else {
+			externalImports[relationship.Type] = true
+		}
+		if relationship.Namespaced {
+			f.UsesScopedAPI = true
+		} else {
+			f.UsesClusterAPI = true
+		}
+	}
+	if f.Status {
+		crateImports["crate::conditions"] = true
+	}
+	if f.UsesScopedAPI {
+		crateImports["crate::controller::{ContextData, Error, Reconciler, scoped_api}"] = true
+	} else {
+		crateImports["crate::controller::{ContextData, Error, Reconciler}"] = true
+	}
+
+	f.CrateImports = sortedKeys(crateImports)
+	f.ExternalImports = sortedKeys(externalImports)
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 //nolint:lll
-const controllerTemplate = `{{ .Boilerplate }}
+const controllerTemplate = `{{ if .Boilerplate }}{{ .Boilerplate }}
 
-use crate::api::{{ lower .Resource.Kind }}_types::{{ .Resource.Kind }};
-use crate::controller::{ContextData, Error, Reconciler};
+{{ end }}
+{{- range .CrateImports }}use {{ . }};
+{{ end -}}
 use async_trait::async_trait;
+{{- range .ExternalImports }}
+use {{ . }};
+{{- end }}
+{{- if or .Owns .Watches }}
+use kube::runtime::controller::Action;
+use kube::runtime::events::EventType;
+{{- if .Finalizer }}
+use kube::runtime::finalizer::{Event, finalizer};
+{{- end }}
+{{- if .Watches }}
+use kube::runtime::reflector::ObjectRef;
+{{- end }}
+use kube::runtime::{Controller, watcher};
+{{- if or .Finalizer .Status .UsesClusterAPI }}
+use kube::{Api, Client, ResourceExt};
+{{- else }}
+use kube::{Client, ResourceExt};
+{{- end }}
+{{- else if .Finalizer }}
+use kube::runtime::controller::Action;
+use kube::runtime::events::EventType;
+use kube::runtime::finalizer::{Event, finalizer};
+use kube::{Api, ResourceExt};
+{{- else if .Status }}
 use kube::runtime::controller::Action;
+use kube::runtime::events::EventType;
+use kube::{Api, ResourceExt};
+{{- else }}
 use kube::ResourceExt;
+use kube::runtime::controller::Action;
+use kube::runtime::events::EventType;
+{{- end }}
 use std::sync::Arc;
 use std::time::Duration;
+use tracing::{error, info};
+{{- if .Finalizer }}
 
+/// Finalizer letting the reconciler clean up before a {{ .Resource.Kind }} is deleted.
+const FINALIZER: &str = "{{ .Resource.QualifiedGroup }}/finalizer";
+{{- end }}
 
+// +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},resources={{ .Resource.Plural }},verbs=get;list;watch;create;update;patch;delete{{ if not .Namespaced }},scope=Cluster{{ end }}
+// +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},resources={{ .Resource.Plural }}/status,verbs=get;update;patch{{ if not .Namespaced }},scope=Cluster{{ end }}
+// +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},resources={{ .Resource.Plural }}/finalizers,verbs=update{{ if not .Namespaced }},scope=Cluster{{ end }}
+{{- range .Owns }}
+// +kubebuilder:rbac:groups={{ .Group }},resources={{ .Plural }},verbs=get;list;watch;create;update;patch;delete{{ if not .Namespaced }},scope=Cluster{{ end }}
+{{- end }}
+{{- range .Watches }}
+// +kubebuilder:rbac:groups={{ .Group }},resources={{ .Plural }},verbs=get;list;watch{{ if not .Namespaced }},scope=Cluster{{ end }}
+{{- end }}
 pub struct {{ .Resource.Kind }}Reconciler;
 
 #[async_trait]
 impl Reconciler<{{ .Resource.Kind }}> for {{ .Resource.Kind }}Reconciler {
-    async fn reconcile(obj: Arc<{{ .Resource.Kind }}>, _ctx: Arc<ContextData>) -> Result<Action, Error> {
-        // TODO(user): your logic here
-		println!("reconcile request: {}", obj.name_any());
-        Ok(Action::requeue(Duration::from_secs(60)))
+{{- if .Finalizer }}
+    async fn reconcile(obj: Arc<{{ .Resource.Kind }}>, ctx: Arc<ContextData>) -> Result<Action, Error> {
+{{- if .Namespaced }}
+        let namespace = obj.namespace().unwrap_or_default();
+        let api: Api<{{ .Resource.Kind }}> = Api::namespaced(ctx.client.clone(), &namespace);
+{{- else }}
+        let api: Api<{{ .Resource.Kind }}> = Api::all(ctx.client.clone());
+{{- end }}
+        finalizer(&api, FINALIZER, obj, |event| async move {
+            match event {
+                Event::Apply(obj) => Self::apply(obj, ctx).await,
+                Event::Cleanup(obj) => Self::cleanup(obj, ctx).await,
+            }
+        })
+        .await
+        .map_err(|err| Error::FinalizerError {
+            source: Box::new(err),
+        })
+    }
+{{- else }}
+    async fn reconcile(obj: Arc<{{ .Resource.Kind }}>, ctx: Arc<ContextData>) -> Result<Action, Error> {
+{{- template "apply" . }}
     }
+{{- end }}
 
-    fn error_policy(obj: Arc<{{ .Resource.Kind }}>, err: &Error, _ctx: Arc<ContextData>) -> Action {
-		eprintln!("Reconciliation error:\n{:?}.\n{:?}", err, obj);
+    fn error_policy(obj: Arc<{{ .Resource.Kind }}>, err: &Error, ctx: Arc<ContextData>) -> Action {
+{{- if .Namespaced }}
+        error!(
+            namespace = %obj.namespace().unwrap_or_default(),
+            name = %obj.name_any(),
+            error = ?err,
+            "Reconciliation failed"
+        );
+{{- else }}
+        error!(name = %obj.name_any(), error = ?err, "Reconciliation failed");
+{{- end }}
+        let note = err.to_string();
+        tokio::spawn(async move {
+            ctx.publish_event(&*obj, EventType::Warning, "ReconcileFailed", &note)
+                .await;
+        });
         Action::requeue(Duration::from_secs(5))
     }
+{{- if or .Owns .Watches }}
+
+    fn setup(
+        controller: Controller<{{ .Resource.Kind }}>,
+        client: Client,
+        {{ if not .UsesScopedAPI }}_{{ end }}namespace: Option<&str>,
+    ) -> Controller<{{ .Resource.Kind }}> {
+        controller
+{{- range .Owns }}{{ $.ChainBreak }}.owns(
+{{ $.ChainIndent }}    {{ template "api" . }},
+{{ $.ChainIndent }}    watcher::Config::default(),
+{{ $.ChainIndent }})
+{{- end }}
+{{- range .Watches }}{{ $.ChainBreak }}.watches(
+{{ $.ChainIndent }}    {{ template "api" . }},
+{{ $.ChainIndent }}    watcher::Config::default(),
+{{ $.ChainIndent }}    |_obj| -> Option<ObjectRef<{{ $.Resource.Kind }}>> {
+{{ $.ChainIndent }}        // TODO(user): map the {{ .Kind }} to the {{ $.Resource.Kind }} to reconcile
+{{ $.ChainIndent }}        None
+{{ $.ChainIndent }}    },
+{{ $.ChainIndent }})
+{{- end }}
+    }
+{{- end }}
 }
+{{- if .Finalizer }}
+
+impl {{ .Resource.Kind }}Reconciler {
+    /// Brings the cluster to the state described by a {{ .Resource.Kind }}.
+    async fn apply(obj: Arc<{{ .Resource.Kind }}>, ctx: Arc<ContextData>) -> Result<Action, Error> {
+{{- template "apply" . }}
+    }
+
+    /// Releases what a deleted {{ .Resource.Kind }} holds, before the finalizer is removed.
+    async fn cleanup(_obj: Arc<{{ .Resource.Kind }}>, _ctx: Arc<ContextData>) -> Result<Action, Error> {
+        // TODO(user): your cleanup logic here
+        info!("Cleaning up {{ .Resource.Kind }}");
+        Ok(Action::await_change())
+    }
+}
+{{- end }}
+{{- define "apply" }}
+        // TODO(user): your logic here
+        info!("Reconciling {{ .Resource.Kind }}");
+{{- if .Status }}
+{{ template "status" . }}
+{{- end }}
+        ctx.publish_event(
+            &*obj,
+            EventType::Normal,
+            "Reconciled",
+            "Reconciled successfully",
+        )
+        .await;
+        Ok(Action::requeue(Duration::from_secs(60)))
+{{- end }}
+{{- define "status" }}
+        // Reports the outcome of the reconciliation in the status of the {{ .Resource.Kind }}.
+        let mut status = obj.status.clone().unwrap_or_default();
+        let available = conditions::new(&*obj, conditions::AVAILABLE, true, "Reconciled", "");
+        conditions::set(&mut status.conditions, available);
+{{- if .Namespaced }}
+        let namespace = obj.namespace().unwrap_or_default();
+        let api: Api<{{ .Resource.Kind }}> = Api::namespaced(ctx.client.clone(), &namespace);
+{{- else }}
+        let api: Api<{{ .Resource.Kind }}> = Api::all(ctx.client.clone());
+{{- end }}
+        ctx.patch_status(&api, &obj.name_any(), &status).await?;
+{{- end }}
+{{- define "api" }}
+{{- if .Namespaced -}}
+scoped_api::<{{ .Kind }}>(client.clone(), namespace)
+{{- else -}}
+Api::<{{ .Kind }}>::all(client.clone())
+{{- end }}
+{{- end }}
 `
@@ -21,11 +21,11 @@ import (
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
 	"path/filepath"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
-	"strings"
 )
 
 const (
-	writerMarker = "writers"
+	crdMarker            = "crds"
+	storageVersionMarker = "storageversions"
 
 	defaultCRDGeneratorPath = "src/crd_generator.rs"
 )
@@ -44,7 +44,8 @@ func (f *CRDGenerator) SetTemplateDefaults() error {
 	}
 
 	f.TemplateBody = fmt.Sprintf(crdGeneratorTemplate,
-		rust.NewMarkerFor(f.Path, writerMarker),
+		rust.NewMarkerFor(f.Path, storageVersionMarker),
+		rust.NewMarkerFor(f.Path, crdMarker),
 	)
 
 	return nil
@@ -54,9 +55,14 @@ var _ machinery.Inserter = &CRDGeneratorUpdater{}
 
 type CRDGeneratorUpdater struct { //nolint:maligned
 	machinery.ResourceMixin
+	machinery.MultiGroupMixin
 
 	// Flags to indicate which parts need to be included when updating the file
 	WireResource, WireController bool
+
+	// StorageVersion indicates whether the version of the resource is the one its kind is stored in,
+	// which is the first version of the kind
+	StorageVersion bool
 }
 
 // GetPath implements file.Builder
@@ -72,12 +78,15 @@ func (*CRDGeneratorUpdater) GetIfExistsAction() machinery.IfExistsAction {
 // GetMarkers implements file.Inserter
 func (f *CRDGeneratorUpdater) GetMarkers() []machinery.Marker {
 	return []machinery.Marker{
-		rust.NewMarkerFor(defaultCRDGeneratorPath, writerMarker),
+		rust.NewMarkerFor(defaultCRDGeneratorPath, storageVersionMarker),
+		rust.NewMarkerFor(defaultCRDGeneratorPath, crdMarker),
 	}
 }
 
 const (
-	writerCodeFragment = `write_crd_to_yaml(&api::%s_types::%s::crd());
+	storageVersionCodeFragment = `    ("%s.%s", "%s"),
+`
+	crdCodeFragment = `        %s::%s::crd(),
 `
 )
 
@@ -86,44 +95,79 @@ func (f *CRDGeneratorUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
 	fragments := make(machinery.CodeFragmentsMap, 3)
 
 	// If resource is not being provided we are creating the file, not updating it
-	if f.Resource == nil {
+	if f.Resource == nil || !f.WireResource {
 		return fragments
 	}
 
-	// Generate writer code fragments
-	writers := make([]string, 0)
-	if f.WireController {
-		writers = append(writers, fmt.Sprintf(writerCodeFragment, strings.ToLower(f.Resource.Kind), f.Resource.Kind))
+	// Generate storage version and CRD code fragments
+	if f.StorageVersion {
+		fragments[rust.NewMarkerFor(defaultCRDGeneratorPath, storageVersionMarker)] = []string{
+			fmt.Sprintf(storageVersionCodeFragment, f.Resource.Plural, f.Resource.QualifiedGroup(), f.Resource.Version),
+		}
 	}
-
-	// Only store code fragments in the map if the slices are non-empty
-	if len(writers) != 0 {
-		fragments[rust.NewMarkerFor(defaultCRDGeneratorPath, writerMarker)] = writers
+	fragments[rust.NewMarkerFor(defaultCRDGeneratorPath, crdMarker)] = []string{
+		fmt.Sprintf(crdCodeFragment, rust.APIModule(f.Resource.GVK, f.MultiGroup), f.Resource.Kind),
 	}
 
 	return fragments
 }
 
 // nolint:lll
-var crdGeneratorTemplate = `{{ .Boilerplate }}
+var crdGeneratorTemplate = `{{ if .Boilerplate }}{{ .Boilerplate }}
 
-mod api;
+{{ end }}mod api;
 
 use k8s_openapi::apiextensions_apiserver::pkg::apis::apiextensions::v1::CustomResourceDefinition;
 use kube::CustomResourceExt;
+use kube::core::crd::merge_crds;
+use std::collections::BTreeMap;
 use std::fs;
 use std::fs::File;
 
-fn main() {
-    fs::create_dir_all("target/kubernetes").expect("Error creating directory 'target/kubernetes'");
+const CRD_DIR: &str = "config/crd/bases";
+
+/// The version each CRD is stored in, which is the first version of its kind unless changed here.
+/// The objects are converted from and to it when they are served in the other versions.
+const STORAGE_VERSIONS: &[(&str, &str)] = &[
     %s
+];
+
+fn main() {
+    fs::create_dir_all(CRD_DIR).expect("Error creating directory 'config/crd/bases'");
+    let crds: Vec<CustomResourceDefinition> = vec![
+        %s
+    ];
+    for crd in merge_versions(crds) {
+        write_crd_to_yaml(&crd);
+    }
+}
+
+/// Merges the versions of each kind into a single CRD, stored in the version set by STORAGE_VERSIONS.
+fn merge_versions(crds: Vec<CustomResourceDefinition>) -> Vec<CustomResourceDefinition> {
+    let mut versions: BTreeMap<String, Vec<CustomResourceDefinition>> = BTreeMap::new();
+    for crd in crds {
+        let name = crd.metadata.name.clone().unwrap_or_default();
+        versions.entry(name).or_default().push(crd);
+    }
+    versions
+        .into_iter()
+        .map(|(name, crds)| {
+            let storage_version = STORAGE_VERSIONS
+                .iter()
+                .find(|(crd, _)| *crd == name)
+                .map(|(_, version)| *version)
+                .unwrap_or_else(|| panic!("Missing storage version of {name}"));
+            merge_crds(crds, storage_version)
+                .unwrap_or_else(|err| panic!("Error merging the versions of {name}: {err}"))
+        })
+        .collect()
 }
 
 fn write_crd_to_yaml(crd: &CustomResourceDefinition) {
     let file_path = format!(
-        "target/kubernetes/{name}-{version}.yaml",
-        name = crd.metadata.name.clone().unwrap(),
-        version = crd.spec.versions.first().unwrap().name
+        "{CRD_DIR}/{group}_{plural}.yaml",
+        group = crd.spec.group,
+        plural = crd.spec.names.plural
     );
     let file = File::create(file_path).expect("Error creating YAML file");
     serde_yaml::to_writer(file, crd).expect("Error writing to YAML file");
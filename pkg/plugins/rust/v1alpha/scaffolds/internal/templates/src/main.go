@@ -19,14 +19,15 @@ package src
 import (
 	"fmt"
 	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/constants"
 	"path/filepath"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
-	"strings"
 )
 
 const (
-	importMarker = "imports"
-	runnerMarker = "runners"
+	importMarker  = "imports"
+	runnerMarker  = "runners"
+	webhookMarker = "webhooks"
 
 	defaultMainPath = "src/main.rs"
 )
@@ -46,8 +47,10 @@ func (f *Main) SetTemplateDefaults() error {
 	}
 
 	f.TemplateBody = fmt.Sprintf(mainTemplate,
+		rust.NewMarkerFor(f.Path, constants.ModuleMarker),
 		rust.NewMarkerFor(f.Path, importMarker),
 		rust.NewMarkerFor(f.Path, runnerMarker),
+		rust.NewMarkerFor(f.Path, webhookMarker),
 	)
 
 	return nil
@@ -58,9 +61,13 @@ var _ machinery.Inserter = &MainUpdater{}
 // MainUpdater updates src/main.rs to add reconcilers
 type MainUpdater struct { //nolint:maligned
 	machinery.ResourceMixin
+	machinery.MultiGroupMixin
 
 	// Flags to indicate which parts need to be included when updating the file
-	WireResource, WireController bool
+	WireResource, WireController, WireWebhook bool
+
+	// Namespaced indicates whether the kind of the wired controller is namespace-scoped
+	Namespaced bool
 }
 
 // GetPath implements file.Builder
@@ -76,17 +83,25 @@ func (*MainUpdater) GetIfExistsAction() machinery.IfExistsAction {
 // GetMarkers implements file.Inserter
 func (f *MainUpdater) GetMarkers() []machinery.Marker {
 	return []machinery.Marker{
+		rust.NewMarkerFor(defaultMainPath, constants.ModuleMarker),
 		rust.NewMarkerFor(defaultMainPath, importMarker),
 		rust.NewMarkerFor(defaultMainPath, runnerMarker),
+		rust.NewMarkerFor(defaultMainPath, webhookMarker),
 	}
 }
 
 const (
-	reconcilerImportCodeFragment = `use crate::controller::%s_controller::%sReconciler;
+	reconcilerImportCodeFragment = `use %s::%sReconciler;
+`
+	aliasedReconcilerImportCodeFragment = `use %s::%sReconciler as %s;
+`
+	reconcilerSetupCodeFragment = `                tokio::spawn(async {
+                    ControllerRunner::%s::<%s>().await;
+                }),
 `
-	reconcilerSetupCodeFragment = `tokio::spawn(async {
-		ControllerRunner::run::<%sReconciler>().await;
-	}),
+	webhookModuleCodeFragment = `mod webhook;
+`
+	webhookSetupCodeFragment = `        tokio::spawn(webhook::run()),
 `
 )
 
@@ -99,42 +114,102 @@ func (f *MainUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
 		return fragments
 	}
 
-	// Generate import code fragments
+	// Generate import code fragments, the reconcilers of the kinds of different groups being told apart by
+	// the name of their group in the multigroup layout
 	imports := make([]string, 0)
+	reconciler := f.Resource.Kind + "Reconciler"
 	if f.WireController {
-		imports = append(imports, fmt.Sprintf(reconcilerImportCodeFragment, strings.ToLower(f.Resource.Kind), f.Resource.Kind))
+		module := rust.ControllerModule(f.Resource.GVK, f.MultiGroup)
+		if f.MultiGroup {
+			reconciler = rust.GroupTypePrefix(f.Resource.Group) + reconciler
+			imports = append(imports, fmt.Sprintf(aliasedReconcilerImportCodeFragment, module, f.Resource.Kind, reconciler))
+		} else {
+			imports = append(imports, fmt.Sprintf(reconcilerImportCodeFragment, module, f.Resource.Kind))
+		}
 	}
 
 	// Generate setup code fragments
 	setup := make([]string, 0)
 	if f.WireController {
-		setup = append(setup, fmt.Sprintf(reconcilerSetupCodeFragment, f.Resource.Kind))
+		// Namespaced kinds may restrict their watch to some namespaces, which is not possible for cluster-scoped ones.
+		run := "run_namespaced"
+		if !f.Namespaced {
+			run = "run"
+		}
+		setup = append(setup, fmt.Sprintf(reconcilerSetupCodeFragment, run, reconciler))
+	}
+
+	// Generate webhook server code fragments
+	modules := make([]string, 0)
+	webhooks := make([]string, 0)
+	if f.WireWebhook {
+		modules = append(modules, webhookModuleCodeFragment)
+		webhooks = append(webhooks, webhookSetupCodeFragment)
 	}
 
 	// Only store code fragments in the map if the slices are non-empty
+	if len(modules) != 0 {
+		fragments[rust.NewMarkerFor(defaultMainPath, constants.ModuleMarker)] = modules
+	}
 	if len(imports) != 0 {
 		fragments[rust.NewMarkerFor(defaultMainPath, importMarker)] = imports
 	}
 	if len(setup) != 0 {
 		fragments[rust.NewMarkerFor(defaultMainPath, runnerMarker)] = setup
 	}
+	if len(webhooks) != 0 {
+		fragments[rust.NewMarkerFor(defaultMainPath, webhookMarker)] = webhooks
+	}
 
 	return fragments
 }
 
 // nolint:lll
-var mainTemplate = `{{ .Boilerplate }}
+var mainTemplate = `{{ if .Boilerplate }}{{ .Boilerplate }}
 
-mod api;
+{{ end }}mod api;
+mod conditions;
 mod controller;
+mod leader_election;
+mod metrics;
+%s
 
 use crate::controller::ControllerRunner;
 %s
 
 #[tokio::main]
 async fn main() {
+    init_tracing();
+
+    // The controllers only run in the replica elected as leader, while the
+    // webhooks are served by all of them.
+    let leader_election = leader_election::LeaderElection::from_env_and_args();
     let _ = tokio::join!(
+        tokio::spawn(metrics::serve()),
+        tokio::spawn(leader_election.run(async {
+            let _ = tokio::join!(
+                %s
+            );
+        })),
         %s
     );
 }
+
+/// Sets up the logging of the manager.
+///
+/// The logs are written as JSON when the --log-format=json flag or the
+/// LOG_FORMAT=json environment variable is set, and as text otherwise. Their
+/// verbosity is set with RUST_LOG, info by default.
+fn init_tracing() {
+    let format = std::env::args()
+        .find_map(|arg| arg.strip_prefix("--log-format=").map(String::from))
+        .or_else(|| std::env::var("LOG_FORMAT").ok());
+    let filter = tracing_subscriber::EnvFilter::try_from_default_env()
+        .unwrap_or_else(|_| tracing_subscriber::EnvFilter::new("info"));
+    let subscriber = tracing_subscriber::fmt().with_env_filter(filter);
+    match format.as_deref() {
+        Some("json") => subscriber.json().init(),
+        _ => subscriber.init(),
+    }
+}
 `
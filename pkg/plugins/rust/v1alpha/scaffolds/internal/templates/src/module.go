@@ -0,0 +1,102 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package src
+
+import (
+	"fmt"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/constants"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &Module{}
+
+// Module scaffolds a mod.rs file declaring the nested modules of a directory, e.g. the types of the kinds
+// of an API version
+type Module struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+}
+
+// SetTemplateDefaults implements file.Template
+func (f *Module) SetTemplateDefaults() error {
+	f.TemplateBody = fmt.Sprintf(moduleTemplate,
+		rust.NewMarkerFor(f.Path, constants.ModuleMarker),
+	)
+
+	// The module is shared by the files of the directory
+	f.IfExistsAction = machinery.SkipFile
+
+	return nil
+}
+
+var _ machinery.Inserter = &ModuleUpdater{}
+
+// ModuleUpdater declares nested modules in a mod.rs file
+type ModuleUpdater struct {
+	// Path is the path of the mod.rs file
+	Path string
+
+	// Modules are the names of the declared modules, sorted as rustfmt does
+	Modules []string
+}
+
+// GetPath implements file.Builder
+func (f *ModuleUpdater) GetPath() string {
+	return f.Path
+}
+
+// GetIfExistsAction implements file.Builder
+func (*ModuleUpdater) GetIfExistsAction() machinery.IfExistsAction {
+	return machinery.OverwriteFile
+}
+
+// GetMarkers implements file.Inserter
+func (f *ModuleUpdater) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{
+		rust.NewMarkerFor(f.Path, constants.ModuleMarker),
+	}
+}
+
+const (
+	nestedModuleCodeFragment = `pub mod %s;
+`
+)
+
+// GetCodeFragments implements file.Inserter
+func (f *ModuleUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
+	fragments := make(machinery.CodeFragmentsMap, 1)
+
+	// Generate module code fragments
+	modules := make([]string, 0, len(f.Modules))
+	for _, module := range f.Modules {
+		modules = append(modules, fmt.Sprintf(nestedModuleCodeFragment, module))
+	}
+
+	// Only store code fragments in the map if the slices are non-empty
+	if len(modules) != 0 {
+		fragments[rust.NewMarkerFor(f.Path, constants.ModuleMarker)] = modules
+	}
+
+	return fragments
+}
+
+// nolint:lll
+var moduleTemplate = `{{ if .Boilerplate }}{{ .Boilerplate }}
+
+{{ end }}%s
+`
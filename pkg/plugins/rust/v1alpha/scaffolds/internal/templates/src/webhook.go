@@ -0,0 +1,160 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package src
+
+import (
+	"fmt"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/constants"
+	"path/filepath"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"strings"
+)
+
+const (
+	routeMarker = "routes"
+
+	defaultWebhookPath = "src/webhook.rs"
+)
+
+var _ machinery.Template = &Webhook{}
+
+// Webhook scaffolds a file that defines the HTTPS server serving the webhooks
+type Webhook struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+}
+
+// SetTemplateDefaults implements file.Template
+func (f *Webhook) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join(defaultWebhookPath)
+	}
+
+	f.TemplateBody = fmt.Sprintf(webhookTemplate,
+		rust.NewMarkerFor(f.Path, constants.ModuleMarker),
+		rust.NewMarkerFor(f.Path, routeMarker),
+	)
+
+	return nil
+}
+
+var _ machinery.Inserter = &WebhookUpdater{}
+
+// WebhookUpdater updates src/webhook.rs to serve the webhooks of a resource
+type WebhookUpdater struct { //nolint:maligned
+	machinery.ResourceMixin
+	machinery.MultiGroupMixin
+}
+
+// GetPath implements file.Builder
+func (*WebhookUpdater) GetPath() string {
+	return defaultWebhookPath
+}
+
+// GetIfExistsAction implements file.Builder
+func (*WebhookUpdater) GetIfExistsAction() machinery.IfExistsAction {
+	return machinery.OverwriteFile
+}
+
+// GetMarkers implements file.Inserter
+func (f *WebhookUpdater) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{
+		rust.NewMarkerFor(defaultWebhookPath, constants.ModuleMarker),
+		rust.NewMarkerFor(defaultWebhookPath, routeMarker),
+	}
+}
+
+const (
+	webhookModuleImportCodeFragment = `pub mod %s;
+`
+	routeCodeFragment = `    let router = router.merge(%s::routes());
+`
+)
+
+// GetCodeFragments implements file.Inserter
+func (f *WebhookUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
+	fragments := make(machinery.CodeFragmentsMap, 2)
+
+	// If resource is not being provided we are creating the file, not updating it
+	if f.Resource == nil {
+		return fragments
+	}
+
+	// The webhooks are nested in the modules of their version and, in the multigroup layout, of their
+	// group, so that the same kind may be served in several groups and versions
+	module := f.Resource.Version
+	if f.MultiGroup {
+		module = rust.GroupModule(f.Resource.Group)
+	}
+	webhookModule := strings.TrimPrefix(rust.WebhookModule(f.Resource.GVK, f.MultiGroup), "crate::webhook::")
+	fragments[rust.NewMarkerFor(defaultWebhookPath, constants.ModuleMarker)] = []string{
+		fmt.Sprintf(webhookModuleImportCodeFragment, module),
+	}
+	fragments[rust.NewMarkerFor(defaultWebhookPath, routeMarker)] = []string{
+		fmt.Sprintf(routeCodeFragment, webhookModule),
+	}
+
+	return fragments
+}
+
+// nolint:lll
+var webhookTemplate = `{{ if .Boilerplate }}{{ .Boilerplate }}
+
+{{ end }}%s
+
+use axum::Router;
+use axum_server::tls_rustls::RustlsConfig;
+use std::env;
+use std::net::SocketAddr;
+use std::path::Path;
+use tracing::info;
+
+const DEFAULT_WEBHOOK_PORT: u16 = 9443;
+const DEFAULT_CERT_DIR: &str = "/tmp/k8s-webhook-server/serving-certs";
+
+/// Builds the router serving the endpoints of every scaffolded webhook.
+fn routes() -> Router {
+    let router = Router::new();
+    %s
+    router
+}
+
+/// Runs the HTTPS server for the admission and conversion webhooks.
+///
+/// The server listens on WEBHOOK_PORT (default 9443) and loads tls.crt and
+/// tls.key from WEBHOOK_CERT_DIR (default /tmp/k8s-webhook-server/serving-certs).
+pub async fn run() {
+    let port = env::var("WEBHOOK_PORT")
+        .ok()
+        .and_then(|port| port.parse().ok())
+        .unwrap_or(DEFAULT_WEBHOOK_PORT);
+    let cert_dir = env::var("WEBHOOK_CERT_DIR").unwrap_or_else(|_| DEFAULT_CERT_DIR.to_string());
+    let cert_dir = Path::new(&cert_dir);
+    let (cert, key) = (cert_dir.join("tls.crt"), cert_dir.join("tls.key"));
+    let tls_config = RustlsConfig::from_pem_file(cert, key)
+        .await
+        .expect("Expected a valid webhook serving certificate.");
+    let addr = SocketAddr::from(([0, 0, 0, 0], port));
+
+    info!("Starting webhook server on {}", addr);
+    axum_server::bind_rustls(addr, tls_config)
+        .serve(routes().into_make_service())
+        .await
+        .expect("Webhook server failed.");
+}
+`
@@ -0,0 +1,247 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	log "github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &Webhook{}
+
+// Webhook scaffolds the file that defines the admission and conversion webhooks for a CRD
+// nolint:maligned
+type Webhook struct {
+	machinery.TemplateMixin
+	machinery.ResourceMixin
+	machinery.BoilerplateMixin
+	machinery.MultiGroupMixin
+
+	// Routes are the webhook endpoints served for the resource
+	Routes []Route
+
+	// APIModule is the path of the module defining the type of the resource
+	APIModule string
+
+	Force bool
+}
+
+// Route is a webhook endpoint, whose path is declared as a constant of the scaffolded file
+type Route struct {
+	Const   string
+	Path    string
+	Handler string
+}
+
+// SetTemplateDefaults implements file.Template
+func (f *Webhook) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join(rust.WebhookDir(f.Resource.GVK, f.MultiGroup), "%[kind]_webhook.rs")
+	}
+
+	f.Path = f.Resource.Replacer().Replace(f.Path)
+	log.Println(f.Path)
+
+	// The paths follow the ones used by controller-runtime, e.g. /mutate-<group>-<version>-<kind>
+	qualifiedGroupWithDash := strings.ReplaceAll(f.Resource.QualifiedGroup(), ".", "-")
+	kind := strings.ToLower(f.Resource.Kind)
+
+	f.APIModule = rust.APIModule(f.Resource.GVK, f.MultiGroup)
+
+	f.Routes = nil
+	webhookTemplate := webhookTemplate
+	if f.Resource.HasDefaultingWebhook() {
+		webhookTemplate += defaultingWebhookTemplate
+		f.Routes = append(f.Routes, Route{
+			Const:   "MUTATE_PATH",
+			Path:    fmt.Sprintf("/mutate-%s-%s-%s", qualifiedGroupWithDash, f.Resource.Version, kind),
+			Handler: "handle_mutate",
+		})
+	}
+	if f.Resource.HasValidationWebhook() {
+		webhookTemplate += validatingWebhookTemplate
+		f.Routes = append(f.Routes, Route{
+			Const:   "VALIDATE_PATH",
+			Path:    fmt.Sprintf("/validate-%s-%s-%s", qualifiedGroupWithDash, f.Resource.Version, kind),
+			Handler: "handle_validate",
+		})
+	}
+	if f.Resource.HasConversionWebhook() {
+		webhookTemplate += conversionWebhookTemplate
+		f.Routes = append(f.Routes, Route{
+			Const:   "CONVERT_PATH",
+			Path:    fmt.Sprintf("/convert-%s-%s", qualifiedGroupWithDash, kind),
+			Handler: "handle_convert",
+		})
+	}
+	f.TemplateBody = webhookTemplate
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
+	return nil
+}
+
+//nolint:lll
+const webhookTemplate = `{{- $admission := or .Resource.HasDefaultingWebhook .Resource.HasValidationWebhook -}}
+{{ if .Boilerplate }}{{ .Boilerplate }}
+
+{{ end }}
+{{- if $admission -}}
+use {{ .APIModule }}::{{ .Resource.Kind }};
+{{ end -}}
+use axum::routing::post;
+use axum::{Json, Router};
+{{- if $admission }}
+use kube::core::DynamicObject;
+use kube::core::admission::{AdmissionRequest, AdmissionResponse, AdmissionReview};
+{{- end }}
+{{- if .Resource.HasConversionWebhook }}
+use kube::core::conversion::{ConversionRequest, ConversionResponse, ConversionReview};
+use kube::core::response::Status;
+use serde_json::Value;
+{{- end }}
+use tracing::warn;
+{{ range .Routes }}
+const {{ .Const }}: &str = "{{ .Path }}";
+{{- end }}
+
+/// Returns the routes serving the {{ .Resource.Kind }} webhooks.
+pub fn routes() -> Router {
+{{- if eq (len .Routes) 1 }}
+    Router::new(){{ range .Routes }}.route({{ .Const }}, post({{ .Handler }})){{ end }}
+{{- else }}
+    Router::new()
+{{- range .Routes }}
+        .route({{ .Const }}, post({{ .Handler }}))
+{{- end }}
+{{- end }}
+}
+{{- if $admission }}
+
+/// Extracts the admission request from a review, answering invalid reviews right away.
+fn admission_request(
+    review: AdmissionReview<{{ .Resource.Kind }}>,
+) -> Result<AdmissionRequest<{{ .Resource.Kind }}>, AdmissionReview<DynamicObject>> {
+    review.try_into().map_err(|err| {
+        warn!(error = %err, "Invalid admission review");
+        AdmissionResponse::invalid(err.to_string()).into_review()
+    })
+}
+{{- end }}
+`
+
+//nolint:lll
+const defaultingWebhookTemplate = `
+async fn handle_mutate(
+    Json(review): Json<AdmissionReview<{{ .Resource.Kind }}>>,
+) -> Json<AdmissionReview<DynamicObject>> {
+    let req = match admission_request(review) {
+        Ok(req) => req,
+        Err(review) => return Json(review),
+    };
+
+    let mut res = AdmissionResponse::from(&req);
+    if let Some(obj) = &req.object {
+        let patches = default(obj);
+        if !patches.is_empty() {
+            res = match res.with_patch(json_patch::Patch(patches)) {
+                Ok(res) => res,
+                Err(err) => AdmissionResponse::from(&req).deny(err.to_string()),
+            };
+        }
+    }
+    Json(res.into_review())
+}
+
+/// Returns the JSON patch operations setting the default values of a {{ .Resource.Kind }}.
+fn default(_obj: &{{ .Resource.Kind }}) -> Vec<json_patch::PatchOperation> {
+    // TODO(user): fill in your defaulting logic.
+    Vec::new()
+}
+`
+
+//nolint:lll
+const validatingWebhookTemplate = `
+async fn handle_validate(
+    Json(review): Json<AdmissionReview<{{ .Resource.Kind }}>>,
+) -> Json<AdmissionReview<DynamicObject>> {
+    let req = match admission_request(review) {
+        Ok(req) => req,
+        Err(review) => return Json(review),
+    };
+
+    let res = AdmissionResponse::from(&req);
+    let res = match validate(&req) {
+        Ok(()) => res,
+        Err(reason) => res.deny(reason),
+    };
+    Json(res.into_review())
+}
+
+/// Validates a {{ .Resource.Kind }} upon creation, update and deletion.
+///
+/// The request operation tells which one is performed, while the object and
+/// the old object hold the new and the previous state of the resource.
+fn validate(_req: &AdmissionRequest<{{ .Resource.Kind }}>) -> Result<(), String> {
+    // TODO(user): fill in your validation logic.
+    Ok(())
+}
+`
+
+//nolint:lll
+const conversionWebhookTemplate = `
+async fn handle_convert(Json(review): Json<ConversionReview>) -> Json<ConversionReview> {
+    let req = match ConversionRequest::from_review(review) {
+        Ok(req) => req,
+        Err(err) => {
+            warn!(error = %err, "Invalid conversion review");
+            let status = Status::failure(&err.to_string(), "InvalidRequest");
+            return Json(ConversionResponse::invalid(status).into_review());
+        }
+    };
+
+    let converted: Result<Vec<Value>, String> = req
+        .objects
+        .iter()
+        .map(|obj| convert(obj, &req.desired_api_version))
+        .collect();
+    let res = ConversionResponse::for_request(req);
+    let res = match converted {
+        Ok(objects) => res.success(objects),
+        Err(reason) => res.failure(Status::failure(&reason, "ConversionFailed")),
+    };
+    Json(res.into_review())
+}
+
+/// Converts a {{ .Resource.Kind }} object into the desired API version.
+fn convert(obj: &Value, desired_api_version: &str) -> Result<Value, String> {
+    // TODO(user): fill in your conversion logic, mapping fields between versions.
+    let mut converted = obj.clone();
+    converted["apiVersion"] = Value::from(desired_api_version);
+    Ok(converted)
+}
+`
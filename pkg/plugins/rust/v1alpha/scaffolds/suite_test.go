@@ -0,0 +1,70 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/internal/golden"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+func TestScaffolds(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "scaffolds")
+}
+
+// testBoilerplate is written before scaffolding so that generated files do not depend on the current year
+const testBoilerplate = `/*
+Copyright 2025.
+*/`
+
+// initTestProject scaffolds a new project into an in-memory filesystem
+func initTestProject() (machinery.Filesystem, config.Config) {
+	return initTestProjectWithHelm(false)
+}
+
+// initTestProjectWithHelm scaffolds a new project into an in-memory filesystem, with a Helm chart if
+// helm is set
+func initTestProjectWithHelm(helm bool) (machinery.Filesystem, config.Config) {
+	fs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+	Expect(afero.WriteFile(fs.FS, filepath.Join("hack", "boilerplate.rs.txt"), []byte(testBoilerplate), 0o644)).
+		To(Succeed())
+
+	cfg := cfgv3.New()
+	Expect(cfg.SetDomain("example.com")).To(Succeed())
+	Expect(cfg.SetProjectName("test-operator")).To(Succeed())
+
+	initScaffolder := NewInitScaffolder(cfg, "apache2", "", "operator-sdk", helm)
+	initScaffolder.InjectFS(fs)
+	Expect(initScaffolder.Scaffold()).To(Succeed())
+
+	return fs, cfg
+}
+
+// expectGolden compares the given scaffolded files against their copies in testdata/<goldenDir>, or
+// refreshes these copies when -update is set
+func expectGolden(fs machinery.Filesystem, goldenDir string, paths ...string) {
+	golden.ExpectFiles(fs.FS, filepath.Join("testdata", goldenDir), paths...)
+}
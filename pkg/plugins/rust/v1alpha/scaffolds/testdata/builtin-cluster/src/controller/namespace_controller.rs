@@ -0,0 +1,44 @@
+/*
+Copyright 2025.
+*/
+
+use crate::controller::{ContextData, Error, Reconciler};
+use async_trait::async_trait;
+use k8s_openapi::api::core::v1::Namespace;
+use kube::ResourceExt;
+use kube::runtime::controller::Action;
+use kube::runtime::events::EventType;
+use std::sync::Arc;
+use std::time::Duration;
+use tracing::{error, info};
+
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create;update;patch;delete,scope=Cluster
+// +kubebuilder:rbac:groups=core,resources=namespaces/status,verbs=get;update;patch,scope=Cluster
+// +kubebuilder:rbac:groups=core,resources=namespaces/finalizers,verbs=update,scope=Cluster
+pub struct NamespaceReconciler;
+
+#[async_trait]
+impl Reconciler<Namespace> for NamespaceReconciler {
+    async fn reconcile(obj: Arc<Namespace>, ctx: Arc<ContextData>) -> Result<Action, Error> {
+        // TODO(user): your logic here
+        info!("Reconciling Namespace");
+        ctx.publish_event(
+            &*obj,
+            EventType::Normal,
+            "Reconciled",
+            "Reconciled successfully",
+        )
+        .await;
+        Ok(Action::requeue(Duration::from_secs(60)))
+    }
+
+    fn error_policy(obj: Arc<Namespace>, err: &Error, ctx: Arc<ContextData>) -> Action {
+        error!(name = %obj.name_any(), error = ?err, "Reconciliation failed");
+        let note = err.to_string();
+        tokio::spawn(async move {
+            ctx.publish_event(&*obj, EventType::Warning, "ReconcileFailed", &note)
+                .await;
+        });
+        Action::requeue(Duration::from_secs(5))
+    }
+}
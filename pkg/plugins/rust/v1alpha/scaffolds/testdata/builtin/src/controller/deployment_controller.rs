@@ -0,0 +1,49 @@
+/*
+Copyright 2025.
+*/
+
+use crate::controller::{ContextData, Error, Reconciler};
+use async_trait::async_trait;
+use k8s_openapi::api::apps::v1::Deployment;
+use kube::ResourceExt;
+use kube::runtime::controller::Action;
+use kube::runtime::events::EventType;
+use std::sync::Arc;
+use std::time::Duration;
+use tracing::{error, info};
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments/finalizers,verbs=update
+pub struct DeploymentReconciler;
+
+#[async_trait]
+impl Reconciler<Deployment> for DeploymentReconciler {
+    async fn reconcile(obj: Arc<Deployment>, ctx: Arc<ContextData>) -> Result<Action, Error> {
+        // TODO(user): your logic here
+        info!("Reconciling Deployment");
+        ctx.publish_event(
+            &*obj,
+            EventType::Normal,
+            "Reconciled",
+            "Reconciled successfully",
+        )
+        .await;
+        Ok(Action::requeue(Duration::from_secs(60)))
+    }
+
+    fn error_policy(obj: Arc<Deployment>, err: &Error, ctx: Arc<ContextData>) -> Action {
+        error!(
+            namespace = %obj.namespace().unwrap_or_default(),
+            name = %obj.name_any(),
+            error = ?err,
+            "Reconciliation failed"
+        );
+        let note = err.to_string();
+        tokio::spawn(async move {
+            ctx.publish_event(&*obj, EventType::Warning, "ReconcileFailed", &note)
+                .await;
+        });
+        Action::requeue(Duration::from_secs(5))
+    }
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025.
+*/
+
+mod api;
+mod conditions;
+mod controller;
+mod leader_election;
+mod metrics;
+// +kubebuilder:scaffold:modules
+
+use crate::controller::ControllerRunner;
+use crate::controller::deployment_controller::DeploymentReconciler;
+// +kubebuilder:scaffold:imports
+
+#[tokio::main]
+async fn main() {
+    init_tracing();
+
+    // The controllers only run in the replica elected as leader, while the
+    // webhooks are served by all of them.
+    let leader_election = leader_election::LeaderElection::from_env_and_args();
+    let _ = tokio::join!(
+        tokio::spawn(metrics::serve()),
+        tokio::spawn(leader_election.run(async {
+            let _ = tokio::join!(
+                tokio::spawn(async {
+                    ControllerRunner::run_namespaced::<DeploymentReconciler>().await;
+                }),
+                // +kubebuilder:scaffold:runners
+            );
+        })),
+        // +kubebuilder:scaffold:webhooks
+    );
+}
+
+/// Sets up the logging of the manager.
+///
+/// The logs are written as JSON when the --log-format=json flag or the
+/// LOG_FORMAT=json environment variable is set, and as text otherwise. Their
+/// verbosity is set with RUST_LOG, info by default.
+fn init_tracing() {
+    let format = std::env::args()
+        .find_map(|arg| arg.strip_prefix("--log-format=").map(String::from))
+        .or_else(|| std::env::var("LOG_FORMAT").ok());
+    let filter = tracing_subscriber::EnvFilter::try_from_default_env()
+        .unwrap_or_else(|_| tracing_subscriber::EnvFilter::new("info"));
+    let subscriber = tracing_subscriber::fmt().with_env_filter(filter);
+    match format.as_deref() {
+        Some("json") => subscriber.json().init(),
+        _ => subscriber.init(),
+    }
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2025.
+*/
+
+use k8s_openapi::apimachinery::pkg::apis::meta::v1::Condition;
+use k8s_openapi::serde::{Deserialize, Serialize};
+use kube::CustomResource;
+use schemars::JsonSchema;
+
+#[derive(CustomResource, Deserialize, Serialize, Clone, Debug, JsonSchema)]
+#[kube(
+    kind = "Tenant",
+    group = "tenancy.example.com",
+    version = "v1",
+    status = "TenantStatus"
+)]
+#[serde(rename_all = "camelCase")]
+pub struct TenantSpec {
+    // INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+
+    // foo is an example field of Tenant. Edit tenant_types.rs to remove/update
+    foo: String,
+}
+
+#[derive(Deserialize, Serialize, Clone, Debug, Default, JsonSchema)]
+#[serde(rename_all = "camelCase")]
+pub struct TenantStatus {
+    // INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+    /// Latest observations of the state of the Tenant.
+    #[serde(default, skip_serializing_if = "Vec::is_empty")]
+    pub conditions: Vec<Condition>,
+}
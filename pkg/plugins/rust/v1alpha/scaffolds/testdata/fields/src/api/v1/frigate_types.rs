@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+*/
+
+use k8s_openapi::apimachinery::pkg::apis::meta::v1::Condition;
+use k8s_openapi::serde::{Deserialize, Serialize};
+use kube::CustomResource;
+use schemars::JsonSchema;
+use std::collections::BTreeMap;
+
+#[derive(CustomResource, Deserialize, Serialize, Clone, Debug, JsonSchema)]
+#[kube(
+    kind = "Frigate",
+    group = "ship.example.com",
+    version = "v1",
+    namespaced,
+    status = "FrigateStatus"
+)]
+#[serde(rename_all = "camelCase")]
+pub struct FrigateSpec {
+    // INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+    /// Image of the Frigate.
+    #[schemars(length(min = 1))]
+    pub image: String,
+
+    /// Replicas of the Frigate.
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub replicas: Option<i32>,
+
+    /// Container ports of the Frigate.
+    #[schemars(length(min = 1))]
+    pub container_ports: Vec<i32>,
+
+    /// Node selector of the Frigate.
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub node_selector: Option<BTreeMap<String, String>>,
+
+    /// Type of the Frigate.
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub r#type: Option<String>,
+}
+
+#[derive(Deserialize, Serialize, Clone, Debug, Default, JsonSchema)]
+#[serde(rename_all = "camelCase")]
+pub struct FrigateStatus {
+    // INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+    /// Ready replicas of the Frigate.
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub ready_replicas: Option<i64>,
+
+    /// Healthy of the Frigate.
+    pub healthy: bool,
+
+    /// Latest observations of the state of the Frigate.
+    #[serde(default, skip_serializing_if = "Vec::is_empty")]
+    pub conditions: Vec<Condition>,
+}
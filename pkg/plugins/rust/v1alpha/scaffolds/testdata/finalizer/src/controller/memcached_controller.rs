@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+*/
+
+use crate::api::v1alpha1::memcached_types::Memcached;
+use crate::conditions;
+use crate::controller::{ContextData, Error, Reconciler};
+use async_trait::async_trait;
+use kube::runtime::controller::Action;
+use kube::runtime::events::EventType;
+use kube::runtime::finalizer::{Event, finalizer};
+use kube::{Api, ResourceExt};
+use std::sync::Arc;
+use std::time::Duration;
+use tracing::{error, info};
+
+/// Finalizer letting the reconciler clean up before a Memcached is deleted.
+const FINALIZER: &str = "cache.example.com/finalizer";
+
+// +kubebuilder:rbac:groups=cache.example.com,resources=memcacheds,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cache.example.com,resources=memcacheds/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cache.example.com,resources=memcacheds/finalizers,verbs=update
+pub struct MemcachedReconciler;
+
+#[async_trait]
+impl Reconciler<Memcached> for MemcachedReconciler {
+    async fn reconcile(obj: Arc<Memcached>, ctx: Arc<ContextData>) -> Result<Action, Error> {
+        let namespace = obj.namespace().unwrap_or_default();
+        let api: Api<Memcached> = Api::namespaced(ctx.client.clone(), &namespace);
+        finalizer(&api, FINALIZER, obj, |event| async move {
+            match event {
+                Event::Apply(obj) => Self::apply(obj, ctx).await,
+                Event::Cleanup(obj) => Self::cleanup(obj, ctx).await,
+            }
+        })
+        .await
+        .map_err(|err| Error::FinalizerError {
+            source: Box::new(err),
+        })
+    }
+
+    fn error_policy(obj: Arc<Memcached>, err: &Error, ctx: Arc<ContextData>) -> Action {
+        error!(
+            namespace = %obj.namespace().unwrap_or_default(),
+            name = %obj.name_any(),
+            error = ?err,
+            "Reconciliation failed"
+        );
+        let note = err.to_string();
+        tokio::spawn(async move {
+            ctx.publish_event(&*obj, EventType::Warning, "ReconcileFailed", &note)
+                .await;
+        });
+        Action::requeue(Duration::from_secs(5))
+    }
+}
+
+impl MemcachedReconciler {
+    /// Brings the cluster to the state described by a Memcached.
+    async fn apply(obj: Arc<Memcached>, ctx: Arc<ContextData>) -> Result<Action, Error> {
+        // TODO(user): your logic here
+        info!("Reconciling Memcached");
+
+        // Reports the outcome of the reconciliation in the status of the Memcached.
+        let mut status = obj.status.clone().unwrap_or_default();
+        let available = conditions::new(&*obj, conditions::AVAILABLE, true, "Reconciled", "");
+        conditions::set(&mut status.conditions, available);
+        let namespace = obj.namespace().unwrap_or_default();
+        let api: Api<Memcached> = Api::namespaced(ctx.client.clone(), &namespace);
+        ctx.patch_status(&api, &obj.name_any(), &status).await?;
+        ctx.publish_event(
+            &*obj,
+            EventType::Normal,
+            "Reconciled",
+            "Reconciled successfully",
+        )
+        .await;
+        Ok(Action::requeue(Duration::from_secs(60)))
+    }
+
+    /// Releases what a deleted Memcached holds, before the finalizer is removed.
+    async fn cleanup(_obj: Arc<Memcached>, _ctx: Arc<ContextData>) -> Result<Action, Error> {
+        // TODO(user): your cleanup logic here
+        info!("Cleaning up Memcached");
+        Ok(Action::await_change())
+    }
+}
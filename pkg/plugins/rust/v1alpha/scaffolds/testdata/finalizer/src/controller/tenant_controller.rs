@@ -0,0 +1,80 @@
+/*
+Copyright 2025.
+*/
+
+use crate::api::v1::tenant_types::Tenant;
+use crate::conditions;
+use crate::controller::{ContextData, Error, Reconciler};
+use async_trait::async_trait;
+use kube::runtime::controller::Action;
+use kube::runtime::events::EventType;
+use kube::runtime::finalizer::{Event, finalizer};
+use kube::{Api, ResourceExt};
+use std::sync::Arc;
+use std::time::Duration;
+use tracing::{error, info};
+
+/// Finalizer letting the reconciler clean up before a Tenant is deleted.
+const FINALIZER: &str = "tenancy.example.com/finalizer";
+
+// +kubebuilder:rbac:groups=tenancy.example.com,resources=tenants,verbs=get;list;watch;create;update;patch;delete,scope=Cluster
+// +kubebuilder:rbac:groups=tenancy.example.com,resources=tenants/status,verbs=get;update;patch,scope=Cluster
+// +kubebuilder:rbac:groups=tenancy.example.com,resources=tenants/finalizers,verbs=update,scope=Cluster
+pub struct TenantReconciler;
+
+#[async_trait]
+impl Reconciler<Tenant> for TenantReconciler {
+    async fn reconcile(obj: Arc<Tenant>, ctx: Arc<ContextData>) -> Result<Action, Error> {
+        let api: Api<Tenant> = Api::all(ctx.client.clone());
+        finalizer(&api, FINALIZER, obj, |event| async move {
+            match event {
+                Event::Apply(obj) => Self::apply(obj, ctx).await,
+                Event::Cleanup(obj) => Self::cleanup(obj, ctx).await,
+            }
+        })
+        .await
+        .map_err(|err| Error::FinalizerError {
+            source: Box::new(err),
+        })
+    }
+
+    fn error_policy(obj: Arc<Tenant>, err: &Error, ctx: Arc<ContextData>) -> Action {
+        error!(name = %obj.name_any(), error = ?err, "Reconciliation failed");
+        let note = err.to_string();
+        tokio::spawn(async move {
+            ctx.publish_event(&*obj, EventType::Warning, "ReconcileFailed", &note)
+                .await;
+        });
+        Action::requeue(Duration::from_secs(5))
+    }
+}
+
+impl TenantReconciler {
+    /// Brings the cluster to the state described by a Tenant.
+    async fn apply(obj: Arc<Tenant>, ctx: Arc<ContextData>) -> Result<Action, Error> {
+        // TODO(user): your logic here
+        info!("Reconciling Tenant");
+
+        // Reports the outcome of the reconciliation in the status of the Tenant.
+        let mut status = obj.status.clone().unwrap_or_default();
+        let available = conditions::new(&*obj, conditions::AVAILABLE, true, "Reconciled", "");
+        conditions::set(&mut status.conditions, available);
+        let api: Api<Tenant> = Api::all(ctx.client.clone());
+        ctx.patch_status(&api, &obj.name_any(), &status).await?;
+        ctx.publish_event(
+            &*obj,
+            EventType::Normal,
+            "Reconciled",
+            "Reconciled successfully",
+        )
+        .await;
+        Ok(Action::requeue(Duration::from_secs(60)))
+    }
+
+    /// Releases what a deleted Tenant holds, before the finalizer is removed.
+    async fn cleanup(_obj: Arc<Tenant>, _ctx: Arc<ContextData>) -> Result<Action, Error> {
+        // TODO(user): your cleanup logic here
+        info!("Cleaning up Tenant");
+        Ok(Action::await_change())
+    }
+}
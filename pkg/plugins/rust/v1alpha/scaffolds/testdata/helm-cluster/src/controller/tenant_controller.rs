@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+*/
+
+use crate::api::v1::tenant_types::Tenant;
+use crate::conditions;
+use crate::controller::{ContextData, Error, Reconciler, scoped_api};
+use async_trait::async_trait;
+use k8s_openapi::api::core::v1::ConfigMap;
+use k8s_openapi::api::core::v1::Namespace;
+use kube::runtime::controller::Action;
+use kube::runtime::events::EventType;
+use kube::runtime::reflector::ObjectRef;
+use kube::runtime::{Controller, watcher};
+use kube::{Api, Client, ResourceExt};
+use std::sync::Arc;
+use std::time::Duration;
+use tracing::{error, info};
+
+// +kubebuilder:rbac:groups=tenancy.example.com,resources=tenants,verbs=get;list;watch;create;update;patch;delete,scope=Cluster
+// +kubebuilder:rbac:groups=tenancy.example.com,resources=tenants/status,verbs=get;update;patch,scope=Cluster
+// +kubebuilder:rbac:groups=tenancy.example.com,resources=tenants/finalizers,verbs=update,scope=Cluster
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create;update;patch;delete,scope=Cluster
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+pub struct TenantReconciler;
+
+#[async_trait]
+impl Reconciler<Tenant> for TenantReconciler {
+    async fn reconcile(obj: Arc<Tenant>, ctx: Arc<ContextData>) -> Result<Action, Error> {
+        // TODO(user): your logic here
+        info!("Reconciling Tenant");
+
+        // Reports the outcome of the reconciliation in the status of the Tenant.
+        let mut status = obj.status.clone().unwrap_or_default();
+        let available = conditions::new(&*obj, conditions::AVAILABLE, true, "Reconciled", "");
+        conditions::set(&mut status.conditions, available);
+        let api: Api<Tenant> = Api::all(ctx.client.clone());
+        ctx.patch_status(&api, &obj.name_any(), &status).await?;
+        ctx.publish_event(
+            &*obj,
+            EventType::Normal,
+            "Reconciled",
+            "Reconciled successfully",
+        )
+        .await;
+        Ok(Action::requeue(Duration::from_secs(60)))
+    }
+
+    fn error_policy(obj: Arc<Tenant>, err: &Error, ctx: Arc<ContextData>) -> Action {
+        error!(name = %obj.name_any(), error = ?err, "Reconciliation failed");
+        let note = err.to_string();
+        tokio::spawn(async move {
+            ctx.publish_event(&*obj, EventType::Warning, "ReconcileFailed", &note)
+                .await;
+        });
+        Action::requeue(Duration::from_secs(5))
+    }
+
+    fn setup(
+        controller: Controller<Tenant>,
+        client: Client,
+        namespace: Option<&str>,
+    ) -> Controller<Tenant> {
+        controller
+            .owns(
+                Api::<Namespace>::all(client.clone()),
+                watcher::Config::default(),
+            )
+            .watches(
+                scoped_api::<ConfigMap>(client.clone(), namespace),
+                watcher::Config::default(),
+                |_obj| -> Option<ObjectRef<Tenant>> {
+                    // TODO(user): map the ConfigMap to the Tenant to reconcile
+                    None
+                },
+            )
+    }
+}
@@ -0,0 +1,33 @@
+{{/*
+Name of the chart, prefixing the names of the objects it creates.
+*/}}
+{{- define "chart.name" -}}
+{{- .Chart.Name | trunc 63 | trimSuffix "-" }}
+{{- end }}
+
+{{/*
+Labels set on all the objects of the chart.
+*/}}
+{{- define "chart.labels" -}}
+helm.sh/chart: {{ .Chart.Name }}-{{ .Chart.Version | replace "+" "_" }}
+app.kubernetes.io/name: {{ include "chart.name" . }}
+app.kubernetes.io/instance: {{ .Release.Name }}
+app.kubernetes.io/version: {{ .Chart.AppVersion | quote }}
+app.kubernetes.io/managed-by: {{ .Release.Service }}
+{{- end }}
+
+{{/*
+Labels selecting the pods of the manager.
+*/}}
+{{- define "chart.selectorLabels" -}}
+control-plane: controller-manager
+app.kubernetes.io/name: {{ include "chart.name" . }}
+app.kubernetes.io/instance: {{ .Release.Name }}
+{{- end }}
+
+{{/*
+Name of the service account of the manager.
+*/}}
+{{- define "chart.serviceAccountName" -}}
+{{ include "chart.name" . }}-controller-manager
+{{- end }}
@@ -0,0 +1,39 @@
+/*
+Copyright 2025.
+*/
+
+use k8s_openapi::apimachinery::pkg::apis::meta::v1::Condition;
+use k8s_openapi::serde::{Deserialize, Serialize};
+use kube::CustomResource;
+use schemars::JsonSchema;
+
+#[derive(CustomResource, Deserialize, Serialize, Clone, Debug, JsonSchema)]
+#[kube(
+    kind = "Cactus",
+    group = "ship.example.com",
+    version = "v1",
+    namespaced,
+    plural = "cactuses",
+    shortname = "cac",
+    shortname = "cs",
+    category = "all",
+    printcolumn = "{\"name\":\"Replicas\",\"type\":\"integer\",\"jsonPath\":\".spec.replicas\"}",
+    printcolumn = "{\"name\":\"Ready\",\"type\":\"string\",\"jsonPath\":\".status.conditions[?(@.type==\\\"Ready\\\")].status\"}",
+    status = "CactusStatus"
+)]
+#[serde(rename_all = "camelCase")]
+pub struct CactusSpec {
+    // INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+
+    // foo is an example field of Cactus. Edit cactus_types.rs to remove/update
+    foo: String,
+}
+
+#[derive(Deserialize, Serialize, Clone, Debug, Default, JsonSchema)]
+#[serde(rename_all = "camelCase")]
+pub struct CactusStatus {
+    // INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+    /// Latest observations of the state of the Cactus.
+    #[serde(default, skip_serializing_if = "Vec::is_empty")]
+    pub conditions: Vec<Condition>,
+}
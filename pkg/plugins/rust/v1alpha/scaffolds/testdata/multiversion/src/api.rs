@@ -0,0 +1,7 @@
+/*
+Copyright 2025.
+*/
+
+pub mod v1alpha1;
+pub mod v1;
+// +kubebuilder:scaffold:modules
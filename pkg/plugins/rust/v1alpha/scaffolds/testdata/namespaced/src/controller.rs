@@ -0,0 +1,241 @@
+/*
+Copyright 2025.
+*/
+
+pub mod memcached_controller;
+// +kubebuilder:scaffold:modules
+
+use crate::metrics::ControllerMetrics;
+use async_trait::async_trait;
+use futures::future::join_all;
+use futures::stream::StreamExt;
+use kube::api::{Patch, PatchParams};
+use kube::core::NamespaceResourceScope;
+use kube::runtime::Controller;
+use kube::runtime::controller::Action;
+use kube::runtime::events::{Event, EventType, Recorder, Reporter};
+use kube::{Api, Client, Resource, ResourceExt};
+use serde::Serialize;
+use serde::de::DeserializeOwned;
+use std::env;
+use std::fmt::Debug;
+use std::hash::Hash;
+use std::marker;
+use std::sync::Arc;
+use tracing::{Instrument, error, info, info_span, warn};
+
+#[async_trait]
+pub trait Reconciler<K: Resource> {
+    async fn reconcile(obj: Arc<K>, ctx: Arc<ContextData>) -> Result<Action, Error>;
+    fn error_policy(obj: Arc<K>, err: &Error, ctx: Arc<ContextData>) -> Action;
+
+    /// Sets up the secondary resources owned or watched by the controller,
+    /// whose APIs are scoped to the namespace it watches, if any.
+    fn setup(controller: Controller<K>, _client: Client, _namespace: Option<&str>) -> Controller<K>
+    where
+        K: Clone + DeserializeOwned + Debug + Send + Sync + 'static,
+        K::DynamicType: Eq + Hash + Clone,
+    {
+        controller
+    }
+}
+
+pub struct ControllerRunner<K: Resource> {
+    _resource_marker: marker::PhantomData<K>,
+}
+
+impl<K> ControllerRunner<K>
+where
+    K: Resource + Clone + DeserializeOwned + Debug + Send + Sync + 'static,
+    K::DynamicType: Default + Eq + Hash + Clone + Debug + Unpin,
+{
+    /// Runs the controller of a cluster-scoped kind, watching the whole cluster.
+    pub async fn run<T: Reconciler<K>>() {
+        let client: Client = Client::try_default()
+            .await
+            .expect("Expected a valid KUBECONFIG environment variable.");
+        Self::run_controllers::<T>(client.clone(), vec![(Api::all(client), None)]).await;
+    }
+
+    /// Runs the controller of a namespaced kind, watching the namespaces listed
+    /// in WATCH_NAMESPACE or the whole cluster when it is empty.
+    pub async fn run_namespaced<T: Reconciler<K>>()
+    where
+        K: Resource<Scope = NamespaceResourceScope>,
+    {
+        let client: Client = Client::try_default()
+            .await
+            .expect("Expected a valid KUBECONFIG environment variable.");
+        let namespaces: Vec<Option<String>> = match watch_namespaces() {
+            namespaces if namespaces.is_empty() => vec![None],
+            namespaces => namespaces.into_iter().map(Some).collect(),
+        };
+        let apis = namespaces
+            .into_iter()
+            .map(|namespace| (scoped_api(client.clone(), namespace.as_deref()), namespace))
+            .collect();
+        Self::run_controllers::<T>(client, apis).await;
+    }
+
+    /// Runs a controller for each of the given APIs and their namespace,
+    /// sharing the context and the metrics of the kind.
+    async fn run_controllers<T: Reconciler<K>>(
+        client: Client,
+        apis: Vec<(Api<K>, Option<String>)>,
+    ) {
+        let context: Arc<ContextData> = Arc::new(ContextData::new(client.clone()));
+        let group = K::group(&Default::default()).to_string();
+        let kind = K::kind(&Default::default()).to_string();
+        let metrics = ControllerMetrics::new(&controller_name(&group, &kind));
+
+        let controllers = apis.into_iter().map(|(api, namespace)| {
+            let (group, kind) = (group.clone(), kind.clone());
+            let metrics = metrics.clone();
+            let controller = Controller::new(api, Default::default());
+            T::setup(controller, client.clone(), namespace.as_deref())
+                .run(
+                    move |obj, ctx| {
+                        // Tags the logs of the reconciliation with the reconciled object.
+                        let span = info_span!(
+                            "reconcile",
+                            group = %group,
+                            kind = %kind,
+                            namespace = %obj.namespace().unwrap_or_default(),
+                            name = %obj.name_any()
+                        );
+                        metrics
+                            .clone()
+                            .measure(<T>::reconcile(obj, ctx))
+                            .instrument(span)
+                    },
+                    <T>::error_policy,
+                    context.clone(),
+                )
+                .for_each(|reconciliation_result| async move {
+                    match reconciliation_result {
+                        Ok(resource) => {
+                            info!(?resource, "Reconciliation successful");
+                        }
+                        Err(reconciliation_err) => {
+                            error!(error = ?reconciliation_err, "Reconciliation error")
+                        }
+                    }
+                })
+        });
+        join_all(controllers).await;
+    }
+}
+
+/// Returns the name of the controller of a kind, which labels its metrics,
+/// qualified by the group of the kind as the same kind may be defined in
+/// several groups, e.g. frigate.ship.example.com.
+fn controller_name(group: &str, kind: &str) -> String {
+    match group {
+        "" => kind.to_lowercase(),
+        group => format!("{}.{}", kind.to_lowercase(), group),
+    }
+}
+
+/// Returns the API of a namespaced kind in the given namespace, or in the
+/// whole cluster.
+pub fn scoped_api<K>(client: Client, namespace: Option<&str>) -> Api<K>
+where
+    K: Resource<Scope = NamespaceResourceScope>,
+    K::DynamicType: Default,
+{
+    match namespace {
+        Some(namespace) => Api::namespaced(client, namespace),
+        None => Api::all(client),
+    }
+}
+
+/// Returns the namespaces listed in the comma-separated WATCH_NAMESPACE
+/// environment variable, none meaning that every namespace is watched.
+fn watch_namespaces() -> Vec<String> {
+    env::var("WATCH_NAMESPACE")
+        .unwrap_or_default()
+        .split(',')
+        .map(str::trim)
+        .filter(|namespace| !namespace.is_empty())
+        .map(String::from)
+        .collect()
+}
+
+/// Name of the manager, which is the field manager of the changes applied by
+/// the controllers and the reporter of their events.
+const MANAGER_NAME: &str = "test-operator";
+
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+pub struct ContextData {
+    client: Client,
+    recorder: Recorder,
+}
+
+impl ContextData {
+    pub fn new(client: Client) -> Self {
+        let reporter = Reporter {
+            controller: MANAGER_NAME.to_string(),
+            instance: env::var("POD_NAME").ok(),
+        };
+        ContextData {
+            recorder: Recorder::new(client.clone(), reporter),
+            client,
+        }
+    }
+
+    /// Publishes an event about the reconciliation of an object. The failures
+    /// to publish it are only logged, so as not to fail the reconciliation.
+    pub async fn publish_event<K>(&self, obj: &K, type_: EventType, reason: &str, note: &str)
+    where
+        K: Resource,
+        K::DynamicType: Default,
+    {
+        let event = Event {
+            type_,
+            reason: reason.to_string(),
+            note: Some(note.to_string()),
+            action: "Reconcile".to_string(),
+            secondary: None,
+        };
+        let reference = obj.object_ref(&Default::default());
+        if let Err(err) = self.recorder.publish(&event, &reference).await {
+            warn!(error = ?err, "Failed to publish event");
+        }
+    }
+
+    /// Applies the status of an object through server-side apply, so that the
+    /// fields of the status set by other writers are neither overwritten nor
+    /// lost to a conflicting update.
+    pub async fn patch_status<K, S>(&self, api: &Api<K>, name: &str, status: &S) -> Result<K, Error>
+    where
+        K: Resource + Clone + DeserializeOwned + Debug,
+        K::DynamicType: Default,
+        S: Serialize,
+    {
+        let patch = serde_json::json!({
+            "apiVersion": K::api_version(&Default::default()),
+            "kind": K::kind(&Default::default()),
+            "status": status,
+        });
+        let params = PatchParams::apply(MANAGER_NAME).force();
+        Ok(api
+            .patch_status(name, &params, &Patch::Apply(&patch))
+            .await?)
+    }
+}
+
+#[derive(Debug, thiserror::Error)]
+pub enum Error {
+    #[error("Kubernetes reported error: {source}")]
+    KubeError {
+        #[from]
+        source: kube::Error,
+    },
+    #[error("Finalizer error: {source}")]
+    FinalizerError {
+        #[source]
+        source: Box<kube::runtime::finalizer::Error<Error>>,
+    },
+    #[error("Invalid Echo CRD: {0}")]
+    UserInputError(String),
+}
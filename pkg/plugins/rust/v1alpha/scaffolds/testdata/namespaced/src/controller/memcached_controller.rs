@@ -0,0 +1,58 @@
+/*
+Copyright 2025.
+*/
+
+use crate::api::v1alpha1::memcached_types::Memcached;
+use crate::conditions;
+use crate::controller::{ContextData, Error, Reconciler};
+use async_trait::async_trait;
+use kube::runtime::controller::Action;
+use kube::runtime::events::EventType;
+use kube::{Api, ResourceExt};
+use std::sync::Arc;
+use std::time::Duration;
+use tracing::{error, info};
+
+// +kubebuilder:rbac:groups=cache.example.com,resources=memcacheds,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cache.example.com,resources=memcacheds/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cache.example.com,resources=memcacheds/finalizers,verbs=update
+pub struct MemcachedReconciler;
+
+#[async_trait]
+impl Reconciler<Memcached> for MemcachedReconciler {
+    async fn reconcile(obj: Arc<Memcached>, ctx: Arc<ContextData>) -> Result<Action, Error> {
+        // TODO(user): your logic here
+        info!("Reconciling Memcached");
+
+        // Reports the outcome of the reconciliation in the status of the Memcached.
+        let mut status = obj.status.clone().unwrap_or_default();
+        let available = conditions::new(&*obj, conditions::AVAILABLE, true, "Reconciled", "");
+        conditions::set(&mut status.conditions, available);
+        let namespace = obj.namespace().unwrap_or_default();
+        let api: Api<Memcached> = Api::namespaced(ctx.client.clone(), &namespace);
+        ctx.patch_status(&api, &obj.name_any(), &status).await?;
+        ctx.publish_event(
+            &*obj,
+            EventType::Normal,
+            "Reconciled",
+            "Reconciled successfully",
+        )
+        .await;
+        Ok(Action::requeue(Duration::from_secs(60)))
+    }
+
+    fn error_policy(obj: Arc<Memcached>, err: &Error, ctx: Arc<ContextData>) -> Action {
+        error!(
+            namespace = %obj.namespace().unwrap_or_default(),
+            name = %obj.name_any(),
+            error = ?err,
+            "Reconciliation failed"
+        );
+        let note = err.to_string();
+        tokio::spawn(async move {
+            ctx.publish_event(&*obj, EventType::Warning, "ReconcileFailed", &note)
+                .await;
+        });
+        Action::requeue(Duration::from_secs(5))
+    }
+}
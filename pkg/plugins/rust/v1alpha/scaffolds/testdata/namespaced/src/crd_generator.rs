@@ -0,0 +1,63 @@
+/*
+Copyright 2025.
+*/
+
+mod api;
+
+use k8s_openapi::apiextensions_apiserver::pkg::apis::apiextensions::v1::CustomResourceDefinition;
+use kube::CustomResourceExt;
+use kube::core::crd::merge_crds;
+use std::collections::BTreeMap;
+use std::fs;
+use std::fs::File;
+
+const CRD_DIR: &str = "config/crd/bases";
+
+/// The version each CRD is stored in, which is the first version of its kind unless changed here.
+/// The objects are converted from and to it when they are served in the other versions.
+const STORAGE_VERSIONS: &[(&str, &str)] = &[
+    ("memcacheds.cache.example.com", "v1alpha1"),
+    // +kubebuilder:scaffold:storageversions
+];
+
+fn main() {
+    fs::create_dir_all(CRD_DIR).expect("Error creating directory 'config/crd/bases'");
+    let crds: Vec<CustomResourceDefinition> = vec![
+        crate::api::v1alpha1::memcached_types::Memcached::crd(),
+        // +kubebuilder:scaffold:crds
+    ];
+    for crd in merge_versions(crds) {
+        write_crd_to_yaml(&crd);
+    }
+}
+
+/// Merges the versions of each kind into a single CRD, stored in the version set by STORAGE_VERSIONS.
+fn merge_versions(crds: Vec<CustomResourceDefinition>) -> Vec<CustomResourceDefinition> {
+    let mut versions: BTreeMap<String, Vec<CustomResourceDefinition>> = BTreeMap::new();
+    for crd in crds {
+        let name = crd.metadata.name.clone().unwrap_or_default();
+        versions.entry(name).or_default().push(crd);
+    }
+    versions
+        .into_iter()
+        .map(|(name, crds)| {
+            let storage_version = STORAGE_VERSIONS
+                .iter()
+                .find(|(crd, _)| *crd == name)
+                .map(|(_, version)| *version)
+                .unwrap_or_else(|| panic!("Missing storage version of {name}"));
+            merge_crds(crds, storage_version)
+                .unwrap_or_else(|err| panic!("Error merging the versions of {name}: {err}"))
+        })
+        .collect()
+}
+
+fn write_crd_to_yaml(crd: &CustomResourceDefinition) {
+    let file_path = format!(
+        "{CRD_DIR}/{group}_{plural}.yaml",
+        group = crd.spec.group,
+        plural = crd.spec.names.plural
+    );
+    let file = File::create(file_path).expect("Error creating YAML file");
+    serde_yaml::to_writer(file, crd).expect("Error writing to YAML file");
+}
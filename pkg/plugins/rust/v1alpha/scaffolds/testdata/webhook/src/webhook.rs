@@ -0,0 +1,48 @@
+/*
+Copyright 2025.
+*/
+
+pub mod v1alpha1;
+// +kubebuilder:scaffold:modules
+
+use axum::Router;
+use axum_server::tls_rustls::RustlsConfig;
+use std::env;
+use std::net::SocketAddr;
+use std::path::Path;
+use tracing::info;
+
+const DEFAULT_WEBHOOK_PORT: u16 = 9443;
+const DEFAULT_CERT_DIR: &str = "/tmp/k8s-webhook-server/serving-certs";
+
+/// Builds the router serving the endpoints of every scaffolded webhook.
+fn routes() -> Router {
+    let router = Router::new();
+    let router = router.merge(v1alpha1::memcached_webhook::routes());
+    // +kubebuilder:scaffold:routes
+    router
+}
+
+/// Runs the HTTPS server for the admission and conversion webhooks.
+///
+/// The server listens on WEBHOOK_PORT (default 9443) and loads tls.crt and
+/// tls.key from WEBHOOK_CERT_DIR (default /tmp/k8s-webhook-server/serving-certs).
+pub async fn run() {
+    let port = env::var("WEBHOOK_PORT")
+        .ok()
+        .and_then(|port| port.parse().ok())
+        .unwrap_or(DEFAULT_WEBHOOK_PORT);
+    let cert_dir = env::var("WEBHOOK_CERT_DIR").unwrap_or_else(|_| DEFAULT_CERT_DIR.to_string());
+    let cert_dir = Path::new(&cert_dir);
+    let (cert, key) = (cert_dir.join("tls.crt"), cert_dir.join("tls.key"));
+    let tls_config = RustlsConfig::from_pem_file(cert, key)
+        .await
+        .expect("Expected a valid webhook serving certificate.");
+    let addr = SocketAddr::from(([0, 0, 0, 0], port));
+
+    info!("Starting webhook server on {}", addr);
+    axum_server::bind_rustls(addr, tls_config)
+        .serve(routes().into_make_service())
+        .await
+        .expect("Webhook server failed.");
+}
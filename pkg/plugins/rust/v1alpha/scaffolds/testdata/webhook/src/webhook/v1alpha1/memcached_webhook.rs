@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+*/
+
+use crate::api::v1alpha1::memcached_types::Memcached;
+use axum::routing::post;
+use axum::{Json, Router};
+use kube::core::DynamicObject;
+use kube::core::admission::{AdmissionRequest, AdmissionResponse, AdmissionReview};
+use kube::core::conversion::{ConversionRequest, ConversionResponse, ConversionReview};
+use kube::core::response::Status;
+use serde_json::Value;
+use tracing::warn;
+
+const MUTATE_PATH: &str = "/mutate-cache-example-com-v1alpha1-memcached";
+const VALIDATE_PATH: &str = "/validate-cache-example-com-v1alpha1-memcached";
+const CONVERT_PATH: &str = "/convert-cache-example-com-memcached";
+
+/// Returns the routes serving the Memcached webhooks.
+pub fn routes() -> Router {
+    Router::new()
+        .route(MUTATE_PATH, post(handle_mutate))
+        .route(VALIDATE_PATH, post(handle_validate))
+        .route(CONVERT_PATH, post(handle_convert))
+}
+
+/// Extracts the admission request from a review, answering invalid reviews right away.
+fn admission_request(
+    review: AdmissionReview<Memcached>,
+) -> Result<AdmissionRequest<Memcached>, AdmissionReview<DynamicObject>> {
+    review.try_into().map_err(|err| {
+        warn!(error = %err, "Invalid admission review");
+        AdmissionResponse::invalid(err.to_string()).into_review()
+    })
+}
+
+async fn handle_mutate(
+    Json(review): Json<AdmissionReview<Memcached>>,
+) -> Json<AdmissionReview<DynamicObject>> {
+    let req = match admission_request(review) {
+        Ok(req) => req,
+        Err(review) => return Json(review),
+    };
+
+    let mut res = AdmissionResponse::from(&req);
+    if let Some(obj) = &req.object {
+        let patches = default(obj);
+        if !patches.is_empty() {
+            res = match res.with_patch(json_patch::Patch(patches)) {
+                Ok(res) => res,
+                Err(err) => AdmissionResponse::from(&req).deny(err.to_string()),
+            };
+        }
+    }
+    Json(res.into_review())
+}
+
+/// Returns the JSON patch operations setting the default values of a Memcached.
+fn default(_obj: &Memcached) -> Vec<json_patch::PatchOperation> {
+    // TODO(user): fill in your defaulting logic.
+    Vec::new()
+}
+
+async fn handle_validate(
+    Json(review): Json<AdmissionReview<Memcached>>,
+) -> Json<AdmissionReview<DynamicObject>> {
+    let req = match admission_request(review) {
+        Ok(req) => req,
+        Err(review) => return Json(review),
+    };
+
+    let res = AdmissionResponse::from(&req);
+    let res = match validate(&req) {
+        Ok(()) => res,
+        Err(reason) => res.deny(reason),
+    };
+    Json(res.into_review())
+}
+
+/// Validates a Memcached upon creation, update and deletion.
+///
+/// The request operation tells which one is performed, while the object and
+/// the old object hold the new and the previous state of the resource.
+fn validate(_req: &AdmissionRequest<Memcached>) -> Result<(), String> {
+    // TODO(user): fill in your validation logic.
+    Ok(())
+}
+
+async fn handle_convert(Json(review): Json<ConversionReview>) -> Json<ConversionReview> {
+    let req = match ConversionRequest::from_review(review) {
+        Ok(req) => req,
+        Err(err) => {
+            warn!(error = %err, "Invalid conversion review");
+            let status = Status::failure(&err.to_string(), "InvalidRequest");
+            return Json(ConversionResponse::invalid(status).into_review());
+        }
+    };
+
+    let converted: Result<Vec<Value>, String> = req
+        .objects
+        .iter()
+        .map(|obj| convert(obj, &req.desired_api_version))
+        .collect();
+    let res = ConversionResponse::for_request(req);
+    let res = match converted {
+        Ok(objects) => res.success(objects),
+        Err(reason) => res.failure(Status::failure(&reason, "ConversionFailed")),
+    };
+    Json(res.into_review())
+}
+
+/// Converts a Memcached object into the desired API version.
+fn convert(obj: &Value, desired_api_version: &str) -> Result<Value, String> {
+    // TODO(user): fill in your conversion logic, mapping fields between versions.
+    let mut converted = obj.clone();
+    converted["apiVersion"] = Value::from(desired_api_version);
+    Ok(converted)
+}
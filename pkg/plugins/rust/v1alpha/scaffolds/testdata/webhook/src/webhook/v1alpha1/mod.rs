@@ -0,0 +1,6 @@
+/*
+Copyright 2025.
+*/
+
+pub mod memcached_webhook;
+// +kubebuilder:scaffold:modules
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/hack"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/src"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds/internal/templates/src/webhook"
+	"github.com/spf13/afero"
+	"log"
+	"os"
+	"path/filepath"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"strings"
+)
+
+var _ plugins.Scaffolder = &webhookScaffolder{}
+
+// webhookScaffolder contains configuration for generating scaffolding for the
+// admission and conversion webhooks of an API.
+type webhookScaffolder struct {
+	config   config.Config
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+
+	// force indicates whether to scaffold webhook files even if it exists or not
+	force bool
+}
+
+// NewWebhookScaffolder returns a new Scaffolder for webhook creation operations
+func NewWebhookScaffolder(config config.Config, res resource.Resource, force bool) plugins.Scaffolder {
+	return &webhookScaffolder{
+		config:   config,
+		resource: res,
+		force:    force,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *webhookScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *webhookScaffolder) Scaffold() error {
+	log.Println("Writing scaffold for you to edit...")
+
+	// Load the boilerplate, which is missing if the project was initialized without a license
+	boilerplate, err := afero.ReadFile(s.fs.FS, hack.DefaultBoilerplatePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error scaffolding webhook: unable to load boilerplate: %w", err)
+	}
+
+	// Initialize the machinery.Scaffold that will write the files to disk
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithBoilerplate(string(boilerplate)),
+		machinery.WithResource(&s.resource),
+	)
+
+	if err := s.config.UpdateResource(s.resource); err != nil {
+		return fmt.Errorf("error updating resource: %w", err)
+	}
+
+	if err := scaffold.Execute(
+		&webhook.Webhook{Force: s.force},
+		&src.Webhook{},
+	); err != nil {
+		return fmt.Errorf("error scaffolding webhook: %v", err)
+	}
+
+	if err := scaffold.Execute(
+		&src.WebhookUpdater{},
+	); err != nil {
+		return fmt.Errorf("error updating src/webhook.rs: %v", err)
+	}
+
+	// The webhooks are nested in the module of their version and, in the multigroup layout, of their group
+	webhookDir := rust.WebhookDir(s.resource.GVK, s.config.IsMultiGroup())
+	if err := scaffoldModule(scaffold, webhookDir, strings.ToLower(s.resource.Kind)+"_webhook"); err != nil {
+		return err
+	}
+	if s.config.IsMultiGroup() {
+		if err := scaffoldModule(scaffold, filepath.Dir(webhookDir), s.resource.Version); err != nil {
+			return err
+		}
+	}
+
+	if err := scaffold.Execute(
+		&src.MainUpdater{WireWebhook: true},
+	); err != nil {
+		return fmt.Errorf("error updating src/main.rs: %v", err)
+	}
+
+	if err := scaffold.Execute(
+		&templates.CargoTomlUpdater{WireWebhook: true},
+	); err != nil {
+		return fmt.Errorf("error updating Cargo.toml: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("Webhook scaffolder", func() {
+	It("should scaffold defaulting, validating and conversion webhooks", func() {
+		fs, cfg := initTestProject()
+
+		res := resource.Resource{
+			GVK: resource.GVK{
+				Group:   "cache",
+				Domain:  "example.com",
+				Version: "v1alpha1",
+				Kind:    "Memcached",
+			},
+			Plural:     "memcacheds",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		}
+		apiScaffolder := NewAPIScaffolder(cfg, res, false, APIOptions{})
+		apiScaffolder.InjectFS(fs)
+		Expect(apiScaffolder.Scaffold()).To(Succeed())
+
+		res.Webhooks = &resource.Webhooks{
+			WebhookVersion: "v1",
+			Defaulting:     true,
+			Validation:     true,
+			Conversion:     true,
+		}
+		webhookScaffolder := NewWebhookScaffolder(cfg, res, false)
+		webhookScaffolder.InjectFS(fs)
+		Expect(webhookScaffolder.Scaffold()).To(Succeed())
+
+		stored, err := cfg.GetResource(res.GVK)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.Webhooks).To(Equal(res.Webhooks))
+
+		expectGolden(fs, "webhook",
+			"Cargo.toml",
+			filepath.Join("src", "main.rs"),
+			filepath.Join("src", "webhook.rs"),
+			filepath.Join("src", "webhook", "v1alpha1", "mod.rs"),
+			filepath.Join("src", "webhook", "v1alpha1", "memcached_webhook.rs"),
+		)
+	})
+})
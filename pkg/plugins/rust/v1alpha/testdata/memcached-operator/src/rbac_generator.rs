@@ -0,0 +1,224 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+use k8s_openapi::api::rbac::v1::{
+    ClusterRole, ClusterRoleBinding, PolicyRule, Role, RoleBinding, RoleRef, Subject,
+};
+use kube::api::ObjectMeta;
+use serde::Serialize;
+use std::collections::{BTreeMap, BTreeSet};
+use std::env;
+use std::fs;
+use std::path::{Path, PathBuf};
+
+const SOURCE_DIR: &str = "src";
+const ROLE_PATH: &str = "config/rbac/role.yaml";
+const ROLE_BINDING_PATH: &str = "config/rbac/role_binding.yaml";
+const RBAC_MARKER: &str = "+kubebuilder:rbac:";
+
+type Rules = BTreeMap<(String, String), BTreeSet<String>>;
+
+/// Generates the role of the manager from the RBAC markers of the sources.
+///
+/// The role is a ClusterRole, unless WATCH_NAMESPACE lists the namespaces
+/// watched by the manager, in which case a Role is generated in each of them.
+/// The rules on cluster-scoped resources, whose markers set scope=Cluster,
+/// cannot be granted by a Role and are kept in a ClusterRole in either case.
+fn main() {
+    let mut rules = Rules::new();
+    let mut cluster_rules = Rules::new();
+    for path in source_files(Path::new(SOURCE_DIR)) {
+        let content = fs::read_to_string(&path).expect("Error reading source file");
+        for line in content.lines() {
+            let marker = line
+                .trim()
+                .strip_prefix("//")
+                .and_then(|comment| comment.trim().strip_prefix(RBAC_MARKER));
+            if let Some(marker) = marker {
+                collect_rules(marker, &mut rules, &mut cluster_rules);
+            }
+        }
+    }
+
+    let namespaces = watch_namespaces();
+    if namespaces.is_empty() {
+        for (key, verbs) in cluster_rules {
+            rules.entry(key).or_default().extend(verbs);
+        }
+        let role = ClusterRole {
+            metadata: metadata("manager-role", None),
+            rules: Some(policy_rules(rules)),
+            ..ClusterRole::default()
+        };
+        let binding = ClusterRoleBinding {
+            metadata: metadata("manager-rolebinding", None),
+            role_ref: role_ref("ClusterRole"),
+            subjects: Some(vec![subject()]),
+        };
+        write_documents(ROLE_PATH, &[yaml(&role)]);
+        write_documents(ROLE_BINDING_PATH, &[yaml(&binding)]);
+    } else {
+        let rules = policy_rules(rules);
+        let mut roles: Vec<String> = namespaces
+            .iter()
+            .map(|namespace| Role {
+                metadata: metadata("manager-role", Some(namespace.as_str())),
+                rules: Some(rules.clone()),
+            })
+            .map(|role| yaml(&role))
+            .collect();
+        let mut bindings: Vec<String> = namespaces
+            .iter()
+            .map(|namespace| RoleBinding {
+                metadata: metadata("manager-rolebinding", Some(namespace.as_str())),
+                role_ref: role_ref("Role"),
+                subjects: Some(vec![subject()]),
+            })
+            .map(|binding| yaml(&binding))
+            .collect();
+        if !cluster_rules.is_empty() {
+            roles.push(yaml(&ClusterRole {
+                metadata: metadata("manager-role", None),
+                rules: Some(policy_rules(cluster_rules)),
+                ..ClusterRole::default()
+            }));
+            bindings.push(yaml(&ClusterRoleBinding {
+                metadata: metadata("manager-rolebinding", None),
+                role_ref: role_ref("ClusterRole"),
+                subjects: Some(vec![subject()]),
+            }));
+        }
+        write_documents(ROLE_PATH, &roles);
+        write_documents(ROLE_BINDING_PATH, &bindings);
+    }
+}
+
+fn policy_rules(rules: Rules) -> Vec<PolicyRule> {
+    rules
+        .into_iter()
+        .map(|((group, resource), verbs)| PolicyRule {
+            api_groups: Some(vec![group]),
+            resources: Some(vec![resource]),
+            verbs: verbs.into_iter().collect(),
+            ..PolicyRule::default()
+        })
+        .collect()
+}
+
+/// Returns the namespaces listed in the comma-separated WATCH_NAMESPACE
+/// environment variable, none meaning that every namespace is watched.
+fn watch_namespaces() -> Vec<String> {
+    env::var("WATCH_NAMESPACE")
+        .unwrap_or_default()
+        .split(',')
+        .map(str::trim)
+        .filter(|namespace| !namespace.is_empty())
+        .map(String::from)
+        .collect()
+}
+
+fn metadata(name: &str, namespace: Option<&str>) -> ObjectMeta {
+    ObjectMeta {
+        name: Some(name.to_string()),
+        namespace: namespace.map(String::from),
+        labels: Some(BTreeMap::from([
+            (
+                "app.kubernetes.io/name".to_string(),
+                env!("CARGO_PKG_NAME").to_string(),
+            ),
+            (
+                "app.kubernetes.io/managed-by".to_string(),
+                "kustomize".to_string(),
+            ),
+        ])),
+        ..ObjectMeta::default()
+    }
+}
+
+fn role_ref(kind: &str) -> RoleRef {
+    RoleRef {
+        api_group: "rbac.authorization.k8s.io".to_string(),
+        kind: kind.to_string(),
+        name: "manager-role".to_string(),
+    }
+}
+
+/// Returns the service account of the manager, whose namespace is set by kustomize.
+fn subject() -> Subject {
+    Subject {
+        kind: "ServiceAccount".to_string(),
+        name: "controller-manager".to_string(),
+        ..Subject::default()
+    }
+}
+
+fn yaml<T: Serialize>(document: &T) -> String {
+    serde_yaml::to_string(document).expect("Error writing to YAML file")
+}
+
+fn write_documents(path: &str, documents: &[String]) {
+    fs::write(path, documents.join("---\n")).expect("Error creating YAML file");
+}
+
+/// Parses a marker of the form groups=<g1;g2>,resources=<r1;r2>,verbs=<v1;v2> and merges the
+/// resulting rules into the given set, or into the cluster-scoped one when the marker sets
+/// scope=Cluster. The core API group may be referred to as "core".
+fn collect_rules(marker: &str, rules: &mut Rules, cluster_rules: &mut Rules) {
+    let mut groups = Vec::new();
+    let mut resources = Vec::new();
+    let mut verbs = Vec::new();
+    let mut cluster_scoped = false;
+    for argument in marker.split(',') {
+        let Some((key, value)) = argument.split_once('=') else {
+            continue;
+        };
+        let values = value
+            .split(';')
+            .map(|v| v.trim().trim_matches('"').to_string());
+        match key.trim() {
+            "groups" => groups.extend(values),
+            "resources" => resources.extend(values),
+            "verbs" => verbs.extend(values),
+            "scope" => cluster_scoped = value.trim() == "Cluster",
+            _ => {}
+        }
+    }
+
+    let rules = if cluster_scoped { cluster_rules } else { rules };
+    for group in &groups {
+        let group = if group == "core" { "" } else { group.as_str() };
+        for resource in &resources {
+            rules
+                .entry((group.to_string(), resource.clone()))
+                .or_default()
+                .extend(verbs.iter().cloned());
+        }
+    }
+}
+
+fn source_files(dir: &Path) -> Vec<PathBuf> {
+    let mut files = Vec::new();
+    for entry in fs::read_dir(dir).expect("Error reading source directory") {
+        let path = entry.expect("Error reading source directory").path();
+        if path.is_dir() {
+            files.extend(source_files(&path));
+        } else if path.extension().is_some_and(|extension| extension == "rs") {
+            files.push(path);
+        }
+    }
+    files.sort();
+    files
+}
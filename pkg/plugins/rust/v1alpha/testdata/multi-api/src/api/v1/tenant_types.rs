@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+use k8s_openapi::apimachinery::pkg::apis::meta::v1::Condition;
+use k8s_openapi::serde::{Deserialize, Serialize};
+use kube::CustomResource;
+use schemars::JsonSchema;
+
+#[derive(CustomResource, Deserialize, Serialize, Clone, Debug, JsonSchema)]
+#[kube(
+    kind = "Tenant",
+    group = "tenancy.example.com",
+    version = "v1",
+    status = "TenantStatus"
+)]
+#[serde(rename_all = "camelCase")]
+pub struct TenantSpec {
+    // INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+
+    // foo is an example field of Tenant. Edit tenant_types.rs to remove/update
+    foo: String,
+}
+
+#[derive(Deserialize, Serialize, Clone, Debug, Default, JsonSchema)]
+#[serde(rename_all = "camelCase")]
+pub struct TenantStatus {
+    // INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+    /// Latest observations of the state of the Tenant.
+    #[serde(default, skip_serializing_if = "Vec::is_empty")]
+    pub conditions: Vec<Condition>,
+}
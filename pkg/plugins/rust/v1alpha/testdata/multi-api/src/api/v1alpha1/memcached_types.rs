@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+use k8s_openapi::apimachinery::pkg::apis::meta::v1::Condition;
+use k8s_openapi::serde::{Deserialize, Serialize};
+use kube::CustomResource;
+use schemars::JsonSchema;
+
+#[derive(CustomResource, Deserialize, Serialize, Clone, Debug, JsonSchema)]
+#[kube(
+    kind = "Memcached",
+    group = "cache.example.com",
+    version = "v1alpha1",
+    namespaced,
+    status = "MemcachedStatus"
+)]
+#[serde(rename_all = "camelCase")]
+pub struct MemcachedSpec {
+    // INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+
+    // foo is an example field of Memcached. Edit memcached_types.rs to remove/update
+    foo: String,
+}
+
+#[derive(Deserialize, Serialize, Clone, Debug, Default, JsonSchema)]
+#[serde(rename_all = "camelCase")]
+pub struct MemcachedStatus {
+    // INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+    /// Latest observations of the state of the Memcached.
+    #[serde(default, skip_serializing_if = "Vec::is_empty")]
+    pub conditions: Vec<Condition>,
+}
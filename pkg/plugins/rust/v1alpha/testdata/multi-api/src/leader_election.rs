@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Operator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+use k8s_openapi::api::coordination::v1::{Lease, LeaseSpec};
+use k8s_openapi::apimachinery::pkg::apis::meta::v1::{MicroTime, ObjectMeta};
+use k8s_openapi::jiff::Timestamp;
+use kube::api::PostParams;
+use kube::{Api, Client};
+use std::env;
+use std::future::Future;
+use std::time::{Duration, Instant};
+use tracing::{error, info, warn};
+
+/// Elects a single leader among the replicas of the operator through a
+/// coordination.k8s.io/v1 Lease, so that only one of them runs the controllers.
+///
+/// It is configured with the following flags, passed as --flag=value, which
+/// override the environment variables given in parentheses:
+///
+/// - --leader-elect (LEADER_ELECT): enables leader election, disabled by default.
+/// - --leader-election-id (LEADER_ELECTION_ID): name of the Lease.
+/// - --leader-election-namespace (LEADER_ELECTION_NAMESPACE): namespace of the
+///   Lease, the one of the pod (POD_NAMESPACE) by default.
+/// - --lease-duration, --renew-deadline and --retry-period (LEASE_DURATION,
+///   RENEW_DEADLINE and RETRY_PERIOD): timings of the election, in seconds.
+pub struct LeaderElection {
+    enabled: bool,
+    id: String,
+    namespace: String,
+    identity: String,
+    lease_duration: Duration,
+    renew_deadline: Duration,
+    retry_period: Duration,
+}
+
+impl LeaderElection {
+    pub fn from_env_and_args() -> Self {
+        let mut options = Options::from_env();
+        options.parse_args(env::args().skip(1));
+
+        LeaderElection {
+            enabled: options.enabled,
+            id: options.id,
+            namespace: options.namespace,
+            identity: env::var("POD_NAME")
+                .or_else(|_| env::var("HOSTNAME"))
+                .unwrap_or_else(|_| format!("{}-{}", env!("CARGO_PKG_NAME"), std::process::id())),
+            lease_duration: Duration::from_secs(options.lease_duration),
+            renew_deadline: Duration::from_secs(options.renew_deadline),
+            retry_period: Duration::from_secs(options.retry_period),
+        }
+    }
+
+    /// Runs the given controllers once the leadership is acquired.
+    ///
+    /// The process exits if the leadership is lost afterwards, so that another
+    /// replica can take over.
+    pub async fn run<F: Future<Output = ()>>(self, controllers: F) {
+        if !self.enabled {
+            controllers.await;
+            return;
+        }
+
+        let client: Client = Client::try_default()
+            .await
+            .expect("Expected a valid KUBECONFIG environment variable.");
+        let leases: Api<Lease> = Api::namespaced(client, &self.namespace);
+
+        info!(
+            "Attempting to acquire leader lease {}/{} as {}",
+            self.namespace, self.id, self.identity
+        );
+        loop {
+            match self.try_acquire_or_renew(&leases).await {
+                Ok(true) => break,
+                Ok(false) => {}
+                Err(err) => warn!(error = ?err, "Failed to acquire leader lease"),
+            }
+            tokio::time::sleep(self.retry_period).await;
+        }
+        info!(
+            "Successfully acquired leader lease {}/{}",
+            self.namespace, self.id
+        );
+
+        tokio::select! {
+            _ = controllers => {}
+            _ = self.keep_renewing(&leases) => {
+                error!("Leader lease {}/{} lost", self.namespace, self.id);
+                std::process::exit(1);
+            }
+        }
+    }
+
+    /// Renews the lease until the leadership is lost.
+    async fn keep_renewing(&self, leases: &Api<Lease>) {
+        let mut renewed_at = Instant::now();
+        loop {
+            tokio::time::sleep(self.retry_period).await;
+            match self.try_acquire_or_renew(leases).await {
+                Ok(true) => renewed_at = Instant::now(),
+                Ok(false) => return,
+                Err(err) => {
+                    warn!(error = ?err, "Failed to renew leader lease");
+                    if renewed_at.elapsed() > self.renew_deadline {
+                        return;
+                    }
+                }
+            }
+        }
+    }
+
+    /// Takes or renews the lease, returning whether this replica holds it.
+    async fn try_acquire_or_renew(&self, leases: &Api<Lease>) -> Result<bool, kube::Error> {
+        let now = MicroTime(Timestamp::now());
+        let Some(mut lease) = leases.get_opt(&self.id).await? else {
+            let lease = Lease {
+                metadata: ObjectMeta {
+                    name: Some(self.id.clone()),
+                    namespace: Some(self.namespace.clone()),
+                    ..ObjectMeta::default()
+                },
+                spec: Some(LeaseSpec {
+                    holder_identity: Some(self.identity.clone()),
+                    lease_duration_seconds: Some(self.lease_duration.as_secs() as i32),
+                    acquire_time: Some(now.clone()),
+                    renew_time: Some(now),
+                    lease_transitions: Some(0),
+                    ..LeaseSpec::default()
+                }),
+            };
+            let result = leases.create(&PostParams::default(), &lease).await;
+            return ignore_conflict(result);
+        };
+
+        let spec = lease.spec.get_or_insert_with(LeaseSpec::default);
+        if spec.holder_identity.as_deref() != Some(self.identity.as_str()) {
+            if !is_expired(spec, &now) {
+                return Ok(false);
+            }
+            spec.holder_identity = Some(self.identity.clone());
+            spec.acquire_time = Some(now.clone());
+            spec.lease_transitions = Some(spec.lease_transitions.unwrap_or_default() + 1);
+        }
+        spec.lease_duration_seconds = Some(self.lease_duration.as_secs() as i32);
+        spec.renew_time = Some(now);
+
+        // The resource version of the lease makes the update fail if another
+        // replica has changed it in the meantime.
+        let result = leases
+            .replace(&self.id, &PostParams::default(), &lease)
+            .await;
+        ignore_conflict(result)
+    }
+}
+
+/// Returns whether the holder of a lease has failed to renew it in time.
+fn is_expired(spec: &LeaseSpec, now: &MicroTime) -> bool {
+    match (&spec.renew_time, spec.lease_duration_seconds) {
+        (Some(renew_time), Some(duration)) => {
+            renew_time.0.as_second() + i64::from(duration) < now.0.as_second()
+        }
+        _ => true,
+    }
+}
+
+/// Maps the conflicts raised when another replica wins the race for the lease.
+fn ignore_conflict(result: Result<Lease, kube::Error>) -> Result<bool, kube::Error> {
+    match result {
+        Ok(_) => Ok(true),
+        Err(kube::Error::Api(err)) if err.code == 409 => Ok(false),
+        Err(err) => Err(err),
+    }
+}
+
+struct Options {
+    enabled: bool,
+    id: String,
+    namespace: String,
+    lease_duration: u64,
+    renew_deadline: u64,
+    retry_period: u64,
+}
+
+impl Options {
+    fn from_env() -> Self {
+        Options {
+            enabled: env::var("LEADER_ELECT").is_ok_and(|value| value == "true"),
+            id: env::var("LEADER_ELECTION_ID")
+                .unwrap_or_else(|_| format!("{}-leader-election", env!("CARGO_PKG_NAME"))),
+            namespace: env::var("LEADER_ELECTION_NAMESPACE")
+                .or_else(|_| env::var("POD_NAMESPACE"))
+                .unwrap_or_else(|_| "default".to_string()),
+            lease_duration: seconds_from_env("LEASE_DURATION", 15),
+            renew_deadline: seconds_from_env("RENEW_DEADLINE", 10),
+            retry_period: seconds_from_env("RETRY_PERIOD", 2),
+        }
+    }
+
+    fn parse_args(&mut self, args: impl Iterator<Item = String>) {
+        for arg in args {
+            let (name, value) = match arg.split_once('=') {
+                Some((name, value)) => (name, Some(value)),
+                None => (arg.as_str(), None),
+            };
+            match (name, value) {
+                ("--leader-elect", None) => self.enabled = true,
+                ("--leader-elect", Some(value)) => self.enabled = value == "true",
+                ("--leader-election-id", Some(value)) => self.id = value.to_string(),
+                ("--leader-election-namespace", Some(value)) => self.namespace = value.to_string(),
+                ("--lease-duration", Some(value)) => {
+                    self.lease_duration = parse_seconds(name, value)
+                }
+                ("--renew-deadline", Some(value)) => {
+                    self.renew_deadline = parse_seconds(name, value)
+                }
+                ("--retry-period", Some(value)) => self.retry_period = parse_seconds(name, value),
+                _ => {}
+            }
+        }
+    }
+}
+
+fn seconds_from_env(name: &str, default: u64) -> u64 {
+    env::var(name).map_or(default, |value| parse_seconds(name, &value))
+}
+
+fn parse_seconds(name: &str, value: &str) -> u64 {
+    value
+        .parse()
+        .unwrap_or_else(|_| panic!("Expected {} to be a number of seconds, got {}", name, value))
+}
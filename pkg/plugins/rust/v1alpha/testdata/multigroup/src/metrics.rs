@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+use axum::Router;
+use axum::http::StatusCode;
+use axum::routing::get;
+use prometheus::{
+    Encoder, Histogram, HistogramVec, IntCounter, IntCounterVec, TextEncoder,
+    register_histogram_vec, register_int_counter_vec,
+};
+use std::env;
+use std::future::Future;
+use std::sync::LazyLock;
+use std::time::Instant;
+use tracing::info;
+
+const DEFAULT_METRICS_BIND_ADDRESS: &str = "0.0.0.0:8080";
+
+static RECONCILE_TOTAL: LazyLock<IntCounterVec> = LazyLock::new(|| {
+    register_int_counter_vec!(
+        "controller_runtime_reconcile_total",
+        "Total number of reconciliations per controller",
+        &["controller", "result"]
+    )
+    .expect("Expected a valid reconcile total metric.")
+});
+
+static RECONCILE_ERRORS: LazyLock<IntCounterVec> = LazyLock::new(|| {
+    register_int_counter_vec!(
+        "controller_runtime_reconcile_errors_total",
+        "Total number of reconciliation errors per controller",
+        &["controller"]
+    )
+    .expect("Expected a valid reconcile errors metric.")
+});
+
+static RECONCILE_TIME: LazyLock<HistogramVec> = LazyLock::new(|| {
+    register_histogram_vec!(
+        "controller_runtime_reconcile_time_seconds",
+        "Length of time per reconciliation per controller",
+        &["controller"]
+    )
+    .expect("Expected a valid reconcile time metric.")
+});
+
+/// Records the reconciliations of a controller.
+#[derive(Clone)]
+pub struct ControllerMetrics {
+    successes: IntCounter,
+    errors: IntCounter,
+    error_total: IntCounter,
+    duration: Histogram,
+}
+
+impl ControllerMetrics {
+    pub fn new(controller: &str) -> Self {
+        ControllerMetrics {
+            successes: RECONCILE_TOTAL.with_label_values(&[controller, "success"]),
+            errors: RECONCILE_TOTAL.with_label_values(&[controller, "error"]),
+            error_total: RECONCILE_ERRORS.with_label_values(&[controller]),
+            duration: RECONCILE_TIME.with_label_values(&[controller]),
+        }
+    }
+
+    /// Runs a reconciliation, recording its outcome and duration.
+    pub async fn measure<T, E>(
+        self,
+        reconciliation: impl Future<Output = Result<T, E>>,
+    ) -> Result<T, E> {
+        let start = Instant::now();
+        let result = reconciliation.await;
+        self.duration.observe(start.elapsed().as_secs_f64());
+        match result {
+            Ok(_) => self.successes.inc(),
+            Err(_) => {
+                self.errors.inc();
+                self.error_total.inc();
+            }
+        }
+        result
+    }
+}
+
+/// Serves the Prometheus metrics on /metrics along with the /healthz and
+/// /readyz endpoints probed by the kubelet.
+///
+/// The server listens on the address given by the --metrics-bind-address flag
+/// or the METRICS_BIND_ADDRESS environment variable (default 0.0.0.0:8080).
+pub async fn serve() {
+    let addr = env::args()
+        .find_map(|arg| {
+            arg.strip_prefix("--metrics-bind-address=")
+                .map(String::from)
+        })
+        .or_else(|| env::var("METRICS_BIND_ADDRESS").ok())
+        .unwrap_or_else(|| DEFAULT_METRICS_BIND_ADDRESS.to_string());
+    let router = Router::new()
+        .route("/metrics", get(metrics))
+        .route("/healthz", get(ping))
+        .route("/readyz", get(ping));
+    let listener = tokio::net::TcpListener::bind(&addr)
+        .await
+        .expect("Expected a valid metrics bind address.");
+
+    info!("Starting metrics server on {}", addr);
+    axum::serve(listener, router)
+        .await
+        .expect("Metrics server failed.");
+}
+
+async fn metrics() -> Result<String, StatusCode> {
+    let mut buffer = Vec::new();
+    TextEncoder::new()
+        .encode(&prometheus::gather(), &mut buffer)
+        .map_err(|_| StatusCode::INTERNAL_SERVER_ERROR)?;
+    String::from_utf8(buffer).map_err(|_| StatusCode::INTERNAL_SERVER_ERROR)
+}
+
+/// Reports the manager as healthy and ready as long as it is able to answer.
+async fn ping() -> &'static str {
+    "ok"
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+use crate::api::ship::v1::frigate_types::Frigate;
+use axum::routing::post;
+use axum::{Json, Router};
+use kube::core::DynamicObject;
+use kube::core::admission::{AdmissionRequest, AdmissionResponse, AdmissionReview};
+use tracing::warn;
+
+const MUTATE_PATH: &str = "/mutate-ship-example-com-v1-frigate";
+
+/// Returns the routes serving the Frigate webhooks.
+pub fn routes() -> Router {
+    Router::new().route(MUTATE_PATH, post(handle_mutate))
+}
+
+/// Extracts the admission request from a review, answering invalid reviews right away.
+fn admission_request(
+    review: AdmissionReview<Frigate>,
+) -> Result<AdmissionRequest<Frigate>, AdmissionReview<DynamicObject>> {
+    review.try_into().map_err(|err| {
+        warn!(error = %err, "Invalid admission review");
+        AdmissionResponse::invalid(err.to_string()).into_review()
+    })
+}
+
+async fn handle_mutate(
+    Json(review): Json<AdmissionReview<Frigate>>,
+) -> Json<AdmissionReview<DynamicObject>> {
+    let req = match admission_request(review) {
+        Ok(req) => req,
+        Err(review) => return Json(review),
+    };
+
+    let mut res = AdmissionResponse::from(&req);
+    if let Some(obj) = &req.object {
+        let patches = default(obj);
+        if !patches.is_empty() {
+            res = match res.with_patch(json_patch::Patch(patches)) {
+                Ok(res) => res,
+                Err(err) => AdmissionResponse::from(&req).deny(err.to_string()),
+            };
+        }
+    }
+    Json(res.into_review())
+}
+
+/// Returns the JSON patch operations setting the default values of a Frigate.
+fn default(_obj: &Frigate) -> Vec<json_patch::PatchOperation> {
+    // TODO(user): fill in your defaulting logic.
+    Vec::new()
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rust
+
+import (
+	"fmt"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust/v1alpha/scaffolds"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+const (
+	defaultingFlag = "defaulting"
+	validationFlag = "programmatic-validation"
+	conversionFlag = "conversion"
+)
+
+var _ plugin.CreateWebhookSubcommand = &createWebhookSubcommand{}
+
+type createWebhookSubcommand struct {
+	config   config.Config
+	resource *resource.Resource
+	options  *rust.Options
+
+	// For help text.
+	commandName string
+
+	// force indicates that the resource should be created even if it already exists
+	force bool
+
+	// skipFmt indicates that cargo fmt should not be run after scaffolding
+	skipFmt bool
+}
+
+func (p *createWebhookSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	p.commandName = cliMeta.CommandName
+
+	subcmdMeta.Description = `Scaffold a webhook for an API resource. You can choose to scaffold defaulting,
+validating and/or conversion webhooks.
+
+The webhooks are served over HTTPS by the "src/webhook.rs" server, which is
+started from "src/main.rs" next to the controller runners.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Create defaulting and validating webhooks for Group: ship, Version: v1beta1
+  # and Kind: Frigate
+  %[1]s create webhook --group ship --version v1beta1 --kind Frigate --defaulting --programmatic-validation
+
+  # Create conversion webhook for Group: ship, Version: v1beta1
+  # and Kind: Frigate
+  %[1]s create webhook --group ship --version v1beta1 --kind Frigate --conversion
+
+  # Edit the webhook handlers
+  vim src/webhook/v1beta1/frigate_webhook.rs
+`, cliMeta.CommandName)
+}
+
+func (p *createWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
+	p.options = &rust.Options{}
+
+	fs.BoolVar(&p.options.DoDefaulting, defaultingFlag, false,
+		"if set, scaffold the defaulting webhook")
+	fs.BoolVar(&p.options.DoValidation, validationFlag, false,
+		"if set, scaffold the validating webhook")
+	fs.BoolVar(&p.options.DoConversion, conversionFlag, false,
+		"if set, scaffold the conversion webhook")
+
+	fs.BoolVar(&p.force, forceFlag, isForced,
+		"attempt to create resource even if it already exists")
+	fs.BoolVar(&p.skipFmt, skipFmtFlag, false,
+		"if set, do not run cargo fmt on the scaffolded code")
+}
+
+func (p *createWebhookSubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+
+	return nil
+}
+
+func (p *createWebhookSubcommand) InjectResource(res *resource.Resource) error {
+	p.resource = res
+
+	p.options.UpdateResource(p.resource, p.config)
+
+	if err := p.resource.Validate(); err != nil {
+		return err
+	}
+
+	if !p.resource.HasDefaultingWebhook() && !p.resource.HasValidationWebhook() && !p.resource.HasConversionWebhook() {
+		return fmt.Errorf("%s create webhook requires at least one of --%s, --%s and --%s to be true",
+			p.commandName, defaultingFlag, validationFlag, conversionFlag)
+	}
+
+	// Check that the resource has an API to attach the webhook to
+	if r, err := p.config.GetResource(p.resource.GVK); err != nil || !r.HasAPI() {
+		return fmt.Errorf("%s create webhook requires a previously created API", p.commandName)
+	} else if r.Webhooks != nil && !r.Webhooks.IsEmpty() && !p.force {
+		return fmt.Errorf("webhook resource already exists")
+	}
+
+	// The conversion webhook of a kind serves all its versions on a single route
+	if p.resource.HasConversionWebhook() {
+		resources, err := p.config.GetResources()
+		if err != nil {
+			return err
+		}
+		for _, r := range resources {
+			if r.IsEqualTo(p.resource.GVK) || r.QualifiedGroup() != p.resource.QualifiedGroup() || r.Kind != p.resource.Kind {
+				continue
+			}
+			if r.HasConversionWebhook() {
+				return fmt.Errorf("the conversion webhook of %s is already served by version %s", r.Kind, r.Version)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *createWebhookSubcommand) PreScaffold(machinery.Filesystem) error {
+	return checkMainPath()
+}
+
+func (p *createWebhookSubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewWebhookScaffolder(p.config, *p.resource, p.force)
+	scaffolder.InjectFS(fs)
+	return scaffolder.Scaffold()
+}
+
+func (p *createWebhookSubcommand) PostScaffold() error {
+	if err := formatCode(p.skipFmt); err != nil {
+		return err
+	}
+
+	// print follow on instructions to better guide the user
+	fmt.Print("Next: implement your new webhook in src/webhook/ and serve it with:\n$ make run\n")
+	return nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025 System Craftsman LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rust
+
+import (
+	"github.com/SystemCraftsman/rust-operator-plugins/pkg/plugins/rust"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("Webhook test", func() {
+	var (
+		testWebhookSubcommand createWebhookSubcommand
+		testConfig            config.Config
+		testResource          resource.Resource
+	)
+
+	BeforeEach(func() {
+		testWebhookSubcommand = createWebhookSubcommand{
+			commandName: "testCommand",
+			options: &rust.Options{
+				DoDefaulting: true,
+			},
+		}
+
+		testResource = resource.Resource{
+			GVK: resource.GVK{
+				Group:   "test-group",
+				Version: "v1",
+				Kind:    "TestKind",
+			},
+			Plural: "testkinds",
+		}
+
+		testConfig, _ = config.New(config.Version{Number: 3})
+	})
+
+	Describe("UpdateResource", func() {
+		It("verify that webhook fields were set", func() {
+			testWebhookOptions := &rust.Options{
+				DoDefaulting: true,
+				DoValidation: true,
+				DoConversion: true,
+			}
+			updateTestResource := resource.Resource{}
+			testWebhookOptions.UpdateResource(&updateTestResource, testConfig)
+			Expect(updateTestResource.Webhooks.WebhookVersion).To(Equal("v1"))
+			Expect(updateTestResource.Webhooks.Defaulting).To(BeTrue())
+			Expect(updateTestResource.Webhooks.Validation).To(BeTrue())
+			Expect(updateTestResource.Webhooks.Conversion).To(BeTrue())
+		})
+	})
+
+	Describe("BindFlags", func() {
+		It("verify all fields were set correctly", func() {
+			flagTest := pflag.NewFlagSet("testFlag", -1)
+			testWebhookSubcommand.BindFlags(flagTest)
+			Expect(testWebhookSubcommand.options.DoDefaulting).To(BeFalse())
+			Expect(testWebhookSubcommand.options.DoValidation).To(BeFalse())
+			Expect(testWebhookSubcommand.options.DoConversion).To(BeFalse())
+			Expect(testWebhookSubcommand.force).To(BeFalse())
+		})
+	})
+
+	Describe("InjectConfig", func() {
+		It("should set config", func() {
+			Expect(testWebhookSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(testWebhookSubcommand.config).To(Equal(testConfig))
+		})
+	})
+
+	Describe("InjectResource", func() {
+		BeforeEach(func() {
+			Expect(testWebhookSubcommand.InjectConfig(testConfig)).To(Succeed())
+		})
+
+		It("verify that a webhook type is required", func() {
+			testWebhookSubcommand.options = &rust.Options{}
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).To(HaveOccurred())
+		})
+
+		It("verify that a previously created API is required", func() {
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).To(HaveOccurred())
+		})
+
+		It("verify that a resource with an API succeeds", func() {
+			apiResource := testResource
+			apiResource.API = &resource.API{CRDVersion: "v1", Namespaced: true}
+			Expect(testConfig.AddResource(apiResource)).To(Succeed())
+
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).To(Succeed())
+			Expect(testWebhookSubcommand.resource.HasDefaultingWebhook()).To(BeTrue())
+		})
+
+		It("verify that existing webhooks are not overwritten without force", func() {
+			apiResource := testResource
+			apiResource.API = &resource.API{CRDVersion: "v1", Namespaced: true}
+			apiResource.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true}
+			Expect(testConfig.AddResource(apiResource)).To(Succeed())
+
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).To(HaveOccurred())
+
+			testWebhookSubcommand.force = true
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).To(Succeed())
+		})
+
+		It("should serve the conversion webhook of a kind from a single version", func() {
+			convertedResource := testResource
+			convertedResource.Version = "v2"
+			convertedResource.API = &resource.API{CRDVersion: "v1", Namespaced: true}
+			convertedResource.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Conversion: true}
+			Expect(testConfig.AddResource(convertedResource)).To(Succeed())
+			apiResource := testResource
+			apiResource.API = &resource.API{CRDVersion: "v1", Namespaced: true}
+			Expect(testConfig.AddResource(apiResource)).To(Succeed())
+
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).To(Succeed())
+
+			testWebhookSubcommand.options.DoConversion = true
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).
+				To(MatchError("the conversion webhook of TestKind is already served by version v2"))
+		})
+	})
+})
@@ -14,5 +14,5 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-pub mod memcached_types;
+pub mod v1alpha1;
 // +kubebuilder:scaffold:modules
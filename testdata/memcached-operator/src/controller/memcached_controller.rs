@@ -1,86 +1,134 @@
-use crate::api::memcached_types::Memcached;
-use crate::controller::{ContextData, Error, Reconciler};
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+use crate::api::v1alpha1::memcached_types::Memcached;
+use crate::conditions;
+use crate::controller::{ContextData, Error, Reconciler, scoped_api};
 use async_trait::async_trait;
-use kube::{Api, Resource, ResourceExt};
+use k8s_openapi::api::apps::v1::{Deployment, DeploymentSpec};
+use k8s_openapi::api::core::v1::{Container, ContainerPort, PodSpec, PodTemplateSpec};
+use k8s_openapi::apimachinery::pkg::apis::meta::v1::{LabelSelector, ObjectMeta};
+use kube::api::PostParams;
 use kube::runtime::controller::Action;
+use kube::runtime::events::EventType;
+use kube::runtime::{Controller, watcher};
+use kube::{Api, Client, Resource, ResourceExt};
 use std::sync::Arc;
 use std::time::Duration;
-use k8s_openapi::api::apps::v1::Deployment;
-use k8s_openapi::api::core::v1::{Container, PodSpec, PodTemplateSpec};
-use k8s_openapi::apimachinery::pkg::apis::meta::v1::{ObjectMeta, OwnerReference, LabelSelector};
-use kube::api::PostParams;
-use log::info;
+use tracing::{error, info};
 
+// +kubebuilder:rbac:groups=cache.example.com,resources=memcacheds,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cache.example.com,resources=memcacheds/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cache.example.com,resources=memcacheds/finalizers,verbs=update
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 pub struct MemcachedReconciler;
 
 #[async_trait]
 impl Reconciler<Memcached> for MemcachedReconciler {
-    async fn reconcile(memcached: Arc<Memcached>, ctx: Arc<ContextData>) -> Result<Action, Error> {
-        let client = &ctx.as_ref().client;
-        let namespace = memcached.namespace().unwrap();
-        let name = memcached.name_any();
-        let deployment_name = format!("{}-deployment", name);
-        let deployments: Api<Deployment> = Api::namespaced(client.clone(), &namespace);
-
-        // Try to get the existing Deployment
-        match deployments.get(&deployment_name).await {
-            Ok(existing_deployment) => {
-                let current_replicas = existing_deployment.spec.as_ref().and_then(|s| s.replicas).unwrap_or(1);
-                let desired_replicas = memcached.spec.size;
+    async fn reconcile(obj: Arc<Memcached>, ctx: Arc<ContextData>) -> Result<Action, Error> {
+        info!("Reconciling Memcached");
+        let namespace = obj.namespace().unwrap_or_default();
+        let deployment_name = format!("{}-deployment", obj.name_any());
+        let deployments: Api<Deployment> = Api::namespaced(ctx.client.clone(), &namespace);
 
-                if current_replicas != desired_replicas {
-                    let mut updated = existing_deployment.clone();
-                    if let Some(spec) = &mut updated.spec {
-                        spec.replicas = Some(desired_replicas);
+        // Creates the Deployment of the Memcached, or scales it to the size of the Memcached.
+        match deployments.get_opt(&deployment_name).await? {
+            Some(mut deployment) => {
+                let current_replicas = deployment.spec.as_ref().and_then(|spec| spec.replicas);
+                if current_replicas != Some(obj.spec.size) {
+                    if let Some(spec) = deployment.spec.as_mut() {
+                        spec.replicas = Some(obj.spec.size);
                     }
-                    deployments.replace(&deployment_name, &PostParams::default(), &updated).await?;
-                    info!("Updated Deployment '{}' to {} replicas", deployment_name, desired_replicas);
+                    deployments
+                        .replace(&deployment_name, &PostParams::default(), &deployment)
+                        .await?;
+                    info!(deployment = %deployment_name, replicas = obj.spec.size, "Scaled Deployment");
                 }
             }
-            Err(kube::Error::Api(e)) if e.code == 404 => {
-                // Doesn't exist, so create it
-                let new_deploy = create_deployment(&memcached)?;
-                deployments.create(&PostParams::default(), &new_deploy).await?;
-                info!("Created Deployment '{}'", deployment_name);
+            None => {
+                deployments
+                    .create(
+                        &PostParams::default(),
+                        &new_deployment(&obj, &deployment_name),
+                    )
+                    .await?;
+                info!(deployment = %deployment_name, "Created Deployment");
             }
-            Err(e) => return Err(e.into()),
         }
 
+        // Reports the outcome of the reconciliation in the status of the Memcached.
+        let mut status = obj.status.clone().unwrap_or_default();
+        let available = conditions::new(&*obj, conditions::AVAILABLE, true, "Reconciled", "");
+        conditions::set(&mut status.conditions, available);
+        let api: Api<Memcached> = Api::namespaced(ctx.client.clone(), &namespace);
+        ctx.patch_status(&api, &obj.name_any(), &status).await?;
+        ctx.publish_event(
+            &*obj,
+            EventType::Normal,
+            "Reconciled",
+            "Reconciled successfully",
+        )
+        .await;
         Ok(Action::requeue(Duration::from_secs(60)))
     }
 
-    fn error_policy(obj: Arc<Memcached>, err: &Error, _ctx: Arc<ContextData>) -> Action {
-        eprintln!("Reconciliation error:\n{:?}.\n{:?}", err, obj);
+    fn error_policy(obj: Arc<Memcached>, err: &Error, ctx: Arc<ContextData>) -> Action {
+        error!(
+            namespace = %obj.namespace().unwrap_or_default(),
+            name = %obj.name_any(),
+            error = ?err,
+            "Reconciliation failed"
+        );
+        let note = err.to_string();
+        tokio::spawn(async move {
+            ctx.publish_event(&*obj, EventType::Warning, "ReconcileFailed", &note)
+                .await;
+        });
         Action::requeue(Duration::from_secs(5))
     }
+
+    fn setup(
+        controller: Controller<Memcached>,
+        client: Client,
+        namespace: Option<&str>,
+    ) -> Controller<Memcached> {
+        controller.owns(
+            scoped_api::<Deployment>(client.clone(), namespace),
+            watcher::Config::default(),
+        )
+    }
 }
 
-fn create_deployment(memcached: &Memcached) -> Result<Deployment, Error> {
-    let name = memcached.name_any();
-    let labels = [("app", name.as_str())]
-        .iter()
-        .cloned()
-        .map(|(k, v)| (k.to_string(), v.to_string()))
-        .collect();
+/// Returns the Deployment running the Memcached, owned by the Memcached so that it is garbage collected
+/// with it.
+fn new_deployment(memcached: &Memcached, name: &str) -> Deployment {
+    let labels: std::collections::BTreeMap<String, String> =
+        [("app".to_string(), memcached.name_any())].into();
 
-    Ok(Deployment {
+    Deployment {
         metadata: ObjectMeta {
-            name: Some(format!("{}-deployment", name)),
+            name: Some(name.to_string()),
             namespace: memcached.namespace(),
-            owner_references: Some(vec![OwnerReference {
-                api_version: Memcached::api_version(&()).to_string(),
-                kind: "Memcached".to_string(),
-                name: name.clone(),
-                uid: memcached.meta().uid.clone().unwrap_or_default(),
-                controller: Some(true),
-                block_owner_deletion: Some(true),
-            }]),
+            owner_references: memcached.controller_owner_ref(&()).map(|owner| vec![owner]),
             ..Default::default()
         },
-        spec: Some(k8s_openapi::api::apps::v1::DeploymentSpec {
+        spec: Some(DeploymentSpec {
             replicas: Some(memcached.spec.size),
             selector: LabelSelector {
-                match_labels: Some([("app".to_string(), name.clone())].into_iter().collect()),
+                match_labels: Some(labels.clone()),
                 ..Default::default()
             },
             template: PodTemplateSpec {
@@ -90,10 +138,10 @@ fn create_deployment(memcached: &Memcached) -> Result<Deployment, Error> {
                 }),
                 spec: Some(PodSpec {
                     containers: vec![Container {
-                        name: "memcached".into(),
-                        image: Some("memcached:1.4.36-alpine".into()),
-                        ports: Some(vec![k8s_openapi::api::core::v1::ContainerPort {
-                            container_port: memcached.spec.container_port,
+                        name: "memcached".to_string(),
+                        image: Some("memcached:1.4.36-alpine".to_string()),
+                        ports: Some(vec![ContainerPort {
+                            container_port: memcached.spec.container_port.unwrap_or(11211),
                             ..Default::default()
                         }]),
                         ..Default::default()
@@ -104,5 +152,5 @@ fn create_deployment(memcached: &Memcached) -> Result<Deployment, Error> {
             ..Default::default()
         }),
         ..Default::default()
-    })
+    }
 }